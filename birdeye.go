@@ -1,7 +1,14 @@
 package birdeye
 
-import "context"
+import (
+	"context"
+	"iter"
+	"time"
+)
 
+// Birdeye is a client for the Birdeye public API. It is safe for concurrent
+// use; requests target the chain given to New unless ctx carries another one
+// set with ContextWithChain.
 type Birdeye interface {
 	// Defi APIs
 
@@ -58,7 +65,7 @@ type Birdeye interface {
 	//
 	// Returns:
 	//   - BirdeyeResponse[PriceMultiple]: response containing price information for the requested tokens
-	//   - error: any error encountered during the API request
+	//   - error: a *ParamError when no address or more than 100 addresses are given, or any error encountered during the API request
 	//
 	// Example usage:
 	//   prices, err := birdeye.PriceMultipleGet(ctx, []string{
@@ -84,7 +91,7 @@ type Birdeye interface {
 	//
 	// Returns:
 	//   - BirdeyeResponse[PriceMultiple]: response containing price information for the requested tokens
-	//   - error: any error encountered during the API request
+	//   - error: a *ParamError when no address or more than 100 addresses are given, or any error encountered during the API request
 	//
 	// Example usage:
 	//   prices, err := birdeye.PriceMultiplePost(ctx, []string{
@@ -100,29 +107,85 @@ type Birdeye interface {
 	//   fmt.Printf("Token prices: %+v\n", prices)
 	PriceMultiplePost(ctx context.Context, addresses []string, opt *PriceOpt) (BirdeyeResponse[PriceMultiple], error)
 
-	// PriceHistorical retrieves the historical price data of a token from the Birdeye API, typically for use in a line chart.
+	// Prices retrieves price updates for any number of tokens using the Birdeye API.
+	// Duplicate addresses are removed, the rest is split into batches of 100 addresses sent through
+	// PriceMultipleGet with bounded concurrency, and the results are merged into one PriceMultiple.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - addresses: []string - a slice of token addresses of any length
+	//   - opt: *PricesOpt - optional parameters to customize the requests (e.g., liquidity options, number of concurrent batches)
+	//
+	// Returns:
+	//   - PriceMultiple: price information for the tokens of every batch that succeeded
+	//   - error: a *PartialError listing the failed batches and their errors, or a *ParamError when no address is given
+	//
+	// Example usage:
+	//   prices, err := birdeye.Prices(ctx, addresses, &PricesOpt{
+	//       Concurrency: 8,
+	//   })
+	//   var partial *PartialError
+	//   if errors.As(err, &partial) {
+	//       log.Printf("%d batches failed", len(partial.Failed))
+	//   } else if err != nil {
+	//       log.Fatalf("failed to retrieve token prices: %v", err)
+	//   }
+	//   fmt.Printf("Token prices: %+v\n", prices)
+	Prices(ctx context.Context, addresses []string, opt *PricesOpt) (PriceMultiple, error)
+
+	// PriceHistorical retrieves the historical price data of a token or pair from the Birdeye API, typically for use in a line chart.
 	// The historical data is fetched based on the parameters provided in the `PriceHistoricalOpt` struct.
+	// A single request returns at most 1000 points; use PriceHistoricalRange for longer spans.
 	//
 	// Parameters:
 	//   - ctx: context.Context - used to control the lifecycle of the API request
-	//   - opt: PriceHistoricalOpt - required options for customizing the historical price query (e.g., token address, time range)
+	//   - opt: PriceHistoricalOpt - required options for customizing the historical price query (address, address type, interval, time range)
 	//
 	// Returns:
-	//   - BirdeyeResponse[Price]: response containing the historical price information of the token
-	//   - error: any error encountered during the API request
+	//   - BirdeyeResponse[PriceHistory]: response containing the price points of the token or pair
+	//   - error: a *ParamError when an option is missing or invalid, or any error encountered during the API request
 	//
 	// Example usage:
-	//   historicalPrice, err := birdeye.PriceHistorical(ctx, PriceHistoricalOpt{
-	//       Address: "So11111111111111111111111111111111111111112",
-	//       Interval: "1h",
-	//       StartTime: 1620000000,
-	//       EndTime:   1623600000,
+	//   history, err := birdeye.PriceHistorical(ctx, PriceHistoricalOpt{
+	//       Address:     "So11111111111111111111111111111111111111112",
+	//       AddressType: AddressTypeToken,
+	//       Type:        Timeframe1H,
+	//       TimeFrom:    time.Unix(1620000000, 0),
+	//       TimeTo:      time.Unix(1623600000, 0),
 	//   })
 	//   if err != nil {
 	//       log.Fatalf("failed to retrieve historical price data: %v", err)
 	//   }
-	//   fmt.Printf("Historical token prices: %+v\n", historicalPrice)
-	PriceHistorical(ctx context.Context, opt PriceHistoricalOpt) (result BirdeyeResponse[Price], err error)
+	//   fmt.Printf("Historical token prices: %+v\n", history)
+	PriceHistorical(ctx context.Context, opt PriceHistoricalOpt) (result BirdeyeResponse[PriceHistory], err error)
+
+	// PriceHistoricalRange retrieves the historical price data of a token or pair over an arbitrarily long time range.
+	// The range of `opt` is split into windows of at most 1000 points which are fetched through PriceHistorical,
+	// optionally in parallel, then merged into a single sorted series without duplicates.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - opt: PriceHistoricalOpt - required options for customizing the historical price query (address, address type, interval, time range)
+	//   - rangeOpt: *PriceHistoricalRangeOpt - optional parameters, such as the number of windows fetched concurrently
+	//
+	// Returns:
+	//   - PriceHistory: the merged price points
+	//   - error: a *ParamError when an option is missing or invalid, or the first error encountered by a window request
+	//
+	// Example usage:
+	//   history, err := birdeye.PriceHistoricalRange(ctx, PriceHistoricalOpt{
+	//       Address:  "So11111111111111111111111111111111111111112",
+	//       Type:     Timeframe5m,
+	//       TimeFrom: time.Now().AddDate(0, -6, 0),
+	//       TimeTo:   time.Now(),
+	//   }, &PriceHistoricalRangeOpt{
+	//       Concurrency: 4,
+	//   })
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve historical price data: %v", err)
+	//   }
+	//   fmt.Printf("Fetched %d price points\n", len(history.Items))
+	PriceHistoricalRange(ctx context.Context, opt PriceHistoricalOpt, rangeOpt *PriceHistoricalRangeOpt) (history PriceHistory, err error)
 
 	// PriceHistoricalUnix retrieves the historical price of a token for a specific Unix timestamp using the Birdeye API.
 	// The request can be customized using optional parameters provided in the `PriceHistoricalUnixOpt` struct.
@@ -130,15 +193,15 @@ type Birdeye interface {
 	// Parameters:
 	//   - ctx: context.Context - used to control the lifecycle of the API request
 	//   - address: string - the token address for which historical price data is requested
-	//   - opt: *PriceHistoricalUnixOpt - optional parameters, such as specifying a Unix timestamp to fetch the price at a specific time
+	//   - opt: *PriceHistoricalUnixOpt - optional parameters, such as specifying a time (not in the future) to fetch the price at
 	//
 	// Returns:
 	//   - BirdeyeResponse[PriceHistoricalUnix]: response containing the historical price information for the specified token and time
-	//   - error: any error encountered during the API request
+	//   - error: a *ParamError when the time is in the future, or any error encountered during the API request
 	//
 	// Example usage:
 	//   historicalPrice, err := birdeye.PriceHistoricalUnix(ctx, "So11111111111111111111111111111111111111112", &PriceHistoricalUnixOpt{
-	//       UnixTime: 1634025600,  // Specify the Unix timestamp (e.g., 1634025600)
+	//       Time: time.Unix(1634025600, 0),  // Specify the time (e.g., 1634025600)
 	//   })
 	//   if err != nil {
 	//       log.Fatalf("failed to retrieve historical price by Unix timestamp: %v", err)
@@ -158,7 +221,7 @@ type Birdeye interface {
 	//   - opt: *Pagination - optional pagination settings to limit and offset the result set
 	//
 	// Returns:
-	//   - BirdeyeResponse[Trade]: response containing trade data for the specified token
+	//   - BirdeyeResponse[TradeList]: response containing a page of trades for the specified token and whether more pages exist
 	//   - error: any error encountered during the API request
 	//
 	// Example usage:
@@ -170,7 +233,7 @@ type Birdeye interface {
 	//       log.Fatalf("failed to retrieve token trades: %v", err)
 	//   }
 	//   fmt.Printf("Token trades: %+v\n", trades)
-	TokenTrades(ctx context.Context, address, tx_type string, sort sortType, opt *Pagination) (result BirdeyeResponse[Trade], err error)
+	TokenTrades(ctx context.Context, address, tx_type string, sort sortType, opt *Pagination) (result BirdeyeResponse[TradeList], err error)
 
 	// PairTrades retrieves a list of trades for a specific trading pair or market using the Birdeye API.
 	// The function allows filtering by trade type (e.g., "buy", "sell") and sorting the results based on the specified criteria.
@@ -184,7 +247,7 @@ type Birdeye interface {
 	//   - opt: *Pagination - optional settings for result pagination (e.g., offset, limit)
 	//
 	// Returns:
-	//   - BirdeyeResponse[Trade]: response containing trade data for the specified trading pair or market
+	//   - BirdeyeResponse[TradeList]: response containing a page of trades for the specified trading pair or market and whether more pages exist
 	//   - error: any error encountered during the API request
 	//
 	// Example usage:
@@ -196,5 +259,574 @@ type Birdeye interface {
 	//       log.Fatalf("failed to retrieve pair trades: %v", err)
 	//   }
 	//   fmt.Printf("Pair trades: %+v\n", trades)
-	PairTrades(ctx context.Context, address, tx_type string, sort sortType, opt *Pagination) (result BirdeyeResponse[Trade], err error)
+	PairTrades(ctx context.Context, address, tx_type string, sort sortType, opt *Pagination) (result BirdeyeResponse[TradeList], err error)
+
+	// TokenTradesAll returns an iterator over all trades of a specific token, walking the pages of TokenTrades transparently.
+	// Iteration stops when the API reports no further page, when the maximum offset served by the API is reached,
+	// when the context is cancelled, or at the first trade past the optional `Until` cutoff.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - address: string - the token address for which trades are requested
+	//   - tx_type: string - the type of trade (e.g., "swap", "add", "remove", "all"), required
+	//   - sort: sortType - the sorting order for trades (e.g., ascending or descending), required
+	//   - opt: *TradesIterOpt - optional page size and block time cutoff
+	//
+	// Returns:
+	//   - iter.Seq2[Trade, error]: sequence of trades; a failed request yields its error once and ends the sequence
+	//
+	// Example usage:
+	//   for trade, err := range birdeye.TokenTradesAll(ctx, "So11111111111111111111111111111111111111112", "swap", SortTypeDesc, &TradesIterOpt{
+	//       Until: time.Now().Add(-time.Hour),
+	//   }) {
+	//       if err != nil {
+	//           log.Fatalf("failed to retrieve token trades: %v", err)
+	//       }
+	//       fmt.Printf("Token trade: %+v\n", trade)
+	//   }
+	TokenTradesAll(ctx context.Context, address, tx_type string, sort sortType, opt *TradesIterOpt) iter.Seq2[Trade, error]
+
+	// PairTradesAll returns an iterator over all trades of a specific trading pair or market, walking the pages of PairTrades transparently.
+	// Iteration stops when the API reports no further page, when the maximum offset served by the API is reached,
+	// when the context is cancelled, or at the first trade past the optional `Until` cutoff.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - address: string - the trading pair or market address for which trades are requested
+	//   - tx_type: string - the type of trade (e.g., "swap", "add", "remove", "all"), required
+	//   - sort: sortType - the sorting order for trades (e.g., ascending or descending), required
+	//   - opt: *TradesIterOpt - optional page size and block time cutoff
+	//
+	// Returns:
+	//   - iter.Seq2[Trade, error]: sequence of trades; a failed request yields its error once and ends the sequence
+	//
+	// Example usage:
+	//   for trade, err := range birdeye.PairTradesAll(ctx, "Czfq3xZZDmsdGdUyrNLtRhGc47cXcZtLG4crryfu44zE", "swap", SortTypeDesc, nil) {
+	//       if err != nil {
+	//           log.Fatalf("failed to retrieve pair trades: %v", err)
+	//       }
+	//       fmt.Printf("Pair trade: %+v\n", trade)
+	//   }
+	PairTradesAll(ctx context.Context, address, tx_type string, sort sortType, opt *TradesIterOpt) iter.Seq2[Trade, error]
+
+	// OHLCV retrieves the candles (open, high, low, close, volume) of a token from the Birdeye API.
+	// Candles of width `timeframe` are returned for the time range between `from` and `to`.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the token address for which candles are requested
+	//   - timeframe: Timeframe - the width of each candle (e.g. Timeframe1m, Timeframe1H, Timeframe1D)
+	//   - from: time.Time - the start of the time range, required
+	//   - to: time.Time - the end of the time range, must be after `from`
+	//
+	// Returns:
+	//   - BirdeyeResponse[OHLCV]: response containing the candles of the token
+	//   - error: a *ParamError for an unsupported timeframe or an invalid range, or any error encountered during the API request
+	//
+	// Example usage:
+	//   candles, err := birdeye.OHLCV(ctx, "So11111111111111111111111111111111111111112", Timeframe15m,
+	//       time.Now().Add(-24*time.Hour), time.Now())
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve candles: %v", err)
+	//   }
+	//   fmt.Printf("Candles: %+v\n", candles)
+	OHLCV(ctx context.Context, address string, timeframe Timeframe, from, to time.Time) (result BirdeyeResponse[OHLCV], err error)
+
+	// PairOHLCV retrieves the candles (open, high, low, close, volume) of a trading pair or pool from the Birdeye API.
+	// Candles of width `timeframe` are returned for the time range between `from` and `to`.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the pair or pool address for which candles are requested
+	//   - timeframe: Timeframe - the width of each candle (e.g. Timeframe1m, Timeframe1H, Timeframe1D)
+	//   - from: time.Time - the start of the time range, required
+	//   - to: time.Time - the end of the time range, must be after `from`
+	//
+	// Returns:
+	//   - BirdeyeResponse[OHLCV]: response containing the candles of the pair
+	//   - error: a *ParamError for an unsupported timeframe or an invalid range, or any error encountered during the API request
+	//
+	// Example usage:
+	//   candles, err := birdeye.PairOHLCV(ctx, "Czfq3xZZDmsdGdUyrNLtRhGc47cXcZtLG4crryfu44zE", Timeframe1H,
+	//       time.Now().AddDate(0, 0, -7), time.Now())
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve pair candles: %v", err)
+	//   }
+	//   fmt.Printf("Pair candles: %+v\n", candles)
+	PairOHLCV(ctx context.Context, address string, timeframe Timeframe, from, to time.Time) (result BirdeyeResponse[OHLCV], err error)
+
+	// BaseQuoteOHLCV retrieves the candles of a base token priced in a quote token from the Birdeye API.
+	// Candles of width `timeframe` are returned for the time range between `from` and `to`,
+	// with volumes reported in both tokens (Candle.BaseVolume and Candle.QuoteVolume).
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - baseAddress: string - the address of the base token, required
+	//   - quoteAddress: string - the address of the quote token, required
+	//   - timeframe: Timeframe - the width of each candle (e.g. Timeframe1m, Timeframe1H, Timeframe1D)
+	//   - from: time.Time - the start of the time range, required
+	//   - to: time.Time - the end of the time range, must be after `from`
+	//
+	// Returns:
+	//   - BirdeyeResponse[OHLCV]: response containing the candles of the base/quote pair
+	//   - error: a *ParamError for a missing address, an unsupported timeframe or an invalid range, or any error encountered during the API request
+	//
+	// Example usage:
+	//   candles, err := birdeye.BaseQuoteOHLCV(ctx,
+	//       "So11111111111111111111111111111111111111112",
+	//       "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", Timeframe15m,
+	//       time.Now().Add(-24*time.Hour), time.Now())
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve base/quote candles: %v", err)
+	//   }
+	//   fmt.Printf("Base/quote candles: %+v\n", candles)
+	BaseQuoteOHLCV(ctx context.Context, baseAddress, quoteAddress string, timeframe Timeframe, from, to time.Time) (result BirdeyeResponse[OHLCV], err error)
+
+	// OHLCVRange retrieves the candles of a token over an arbitrarily long time range from the Birdeye API.
+	// The range between `from` and `to` is split into windows of at most 1000 candles which are fetched
+	// through OHLCV, optionally in parallel, then merged into a sorted series without duplicates.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - address: string - the token address for which candles are requested
+	//   - timeframe: Timeframe - the width of each candle (e.g. Timeframe1m, Timeframe1H, Timeframe1D)
+	//   - from: time.Time - the start of the time range, required
+	//   - to: time.Time - the end of the time range (exclusive), must be after `from`
+	//   - opt: *OHLCVRangeOpt - optional parameters, such as the number of windows fetched concurrently
+	//
+	// Returns:
+	//   - OHLCVSeries: the merged candles and the missing intervals of the range
+	//   - error: a *ParamError for an unsupported timeframe or an invalid range, or the first error encountered by a window request
+	//
+	// Example usage:
+	//   series, err := birdeye.OHLCVRange(ctx, "So11111111111111111111111111111111111111112", Timeframe1m,
+	//       time.Now().AddDate(-1, 0, 0), time.Now(), &OHLCVRangeOpt{
+	//       Concurrency: 4,
+	//   })
+	//   if err != nil {
+	//       log.Fatalf("failed to backfill candles: %v", err)
+	//   }
+	//   fmt.Printf("Fetched %d candles with %d gaps\n", len(series.Candles), len(series.Gaps))
+	OHLCVRange(ctx context.Context, address string, timeframe Timeframe, from, to time.Time, opt *OHLCVRangeOpt) (series OHLCVSeries, err error)
+
+	// Token APIs
+
+	// NewListing retrieves the most recently listed tokens from the Birdeye API.
+	// Listings are returned up to `toTime`, and an optional `NewListingOpt` parameter can be provided
+	// to set the number of results and include meme platform launches.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - toTime: time.Time - only listings up to this time are returned, required and not in the future
+	//   - opt: *NewListingOpt - optional parameters (limit between 1 and 20, meme platform flag)
+	//
+	// Returns:
+	//   - BirdeyeResponse[NewListing]: response containing the newly listed tokens
+	//   - error: a *ParamError when toTime or the limit is invalid, or any error encountered during the API request
+	//
+	// Example usage:
+	//   listings, err := birdeye.NewListing(ctx, time.Now(), &NewListingOpt{
+	//       Limit:               20,
+	//       MemePlatformEnabled: true,
+	//   })
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve new listings: %v", err)
+	//   }
+	//   fmt.Printf("New listings: %+v\n", listings)
+	NewListing(ctx context.Context, toTime time.Time, opt *NewListingOpt) (result BirdeyeResponse[NewListing], err error)
+
+	// TrendingList retrieves the list of trending tokens from the Birdeye API.
+	// The list is sorted according to `param.SortBy` and `param.SortType` and paginated with `param.Offset` and `param.Limit`.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - param: TrandingListParam - sorting (required) and pagination (limit between 1 and 20, defaults to 10)
+	//
+	// Returns:
+	//   - BirdeyeResponse[TrendingList]: response containing the trending tokens
+	//   - error: a *ParamError when a parameter is missing or out of range, or any error encountered during the API request
+	//
+	// Example usage:
+	//   trending, err := birdeye.TrendingList(ctx, TrandingListParam{
+	//       SortBy:   SortByRank,
+	//       SortType: SortTypeAsc,
+	//       Limit:    20,
+	//   })
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve trending tokens: %v", err)
+	//   }
+	//   fmt.Printf("Trending tokens: %+v\n", trending)
+	TrendingList(ctx context.Context, param TrandingListParam) (result BirdeyeResponse[TrendingList], err error)
+
+	// TokenOverview retrieves the market statistics of a token from the Birdeye API.
+	// The overview includes market cap, FDV, supply, holders, social links and, for every window
+	// (Window30m, Window1h, ..., Window24h), price change, trade, buy and sell counts, volumes and unique wallets.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the token, required
+	//
+	// Returns:
+	//   - BirdeyeResponse[TokenOverview]: response containing the overview of the token
+	//   - error: a *ParamError when the address is missing, or any error encountered during the API request
+	//
+	// Example usage:
+	//   overview, err := birdeye.TokenOverview(ctx, "So11111111111111111111111111111111111111112")
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token overview: %v", err)
+	//   }
+	//   day, _ := overview.Data.Window(Window24h)
+	//   fmt.Printf("Market cap: %f, 24h buys: %d\n", overview.Data.MarketCap, day.Buy)
+	TokenOverview(ctx context.Context, address string) (result BirdeyeResponse[TokenOverview], err error)
+
+	// TokenSecurity retrieves the security report of a token from the Birdeye API.
+	// The report depends on the chain the request targets (the client chain or the one set with ContextWithChain):
+	// Solana tokens fill TokenSecurity.Solana, tokens of EVM chains fill TokenSecurity.EVM.
+	// Other chains, such as Sui, are rejected with a *ParamError before any request is sent.
+	// TokenSecurity.RiskFlags turns either report into a list of risks (mint or freeze authority, holder concentration, honeypot, ...).
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the token, required
+	//
+	// Returns:
+	//   - BirdeyeResponse[TokenSecurity]: response containing the security report of the token
+	//   - error: a *ParamError when the address is missing, or any error encountered during the API request
+	//
+	// Example usage:
+	//   security, err := birdeye.TokenSecurity(ctx, "So11111111111111111111111111111111111111112")
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token security: %v", err)
+	//   }
+	//   if slices.Contains(security.Data.RiskFlags(), RiskMintable) {
+	//       fmt.Println("token supply can still be inflated")
+	//   }
+	TokenSecurity(ctx context.Context, address string) (result BirdeyeResponse[TokenSecurity], err error)
+
+	// TokenCreationInfo retrieves the creation details of a token from the Birdeye API,
+	// such as the deploy transaction, the creator and the creation time.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the token, required
+	//
+	// Returns:
+	//   - BirdeyeResponse[TokenCreationInfo]: response containing the creation details of the token
+	//   - error: a *ParamError when the address is missing, or any error encountered during the API request
+	//
+	// Example usage:
+	//   info, err := birdeye.TokenCreationInfo(ctx, "So11111111111111111111111111111111111111112")
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token creation info: %v", err)
+	//   }
+	//   fmt.Printf("Created by %s at %s\n", info.Data.Owner, info.Data.BlockUnixTime)
+	TokenCreationInfo(ctx context.Context, address string) (result BirdeyeResponse[TokenCreationInfo], err error)
+
+	// TokenMetadata retrieves the metadata of a token from the Birdeye API,
+	// such as its name, symbol, decimals, logo and extensions (website, social links).
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the token, required
+	//
+	// Returns:
+	//   - BirdeyeResponse[TokenMetadata]: response containing the metadata of the token
+	//   - error: a *ParamError when the address is missing, or any error encountered during the API request
+	//
+	// Example usage:
+	//   metadata, err := birdeye.TokenMetadata(ctx, "So11111111111111111111111111111111111111112")
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token metadata: %v", err)
+	//   }
+	//   fmt.Printf("Token metadata: %+v\n", metadata)
+	TokenMetadata(ctx context.Context, address string) (result BirdeyeResponse[TokenMetadata], err error)
+
+	// TokenMetadataMultiple retrieves the metadata of any number of tokens using the Birdeye API.
+	// Duplicate addresses are removed, the rest is split into batches of 50 addresses requested
+	// with bounded concurrency, and the results are merged into one map keyed by address.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - addresses: []string - a slice of token addresses of any length
+	//
+	// Returns:
+	//   - TokenMetadataMultiple: metadata of the tokens of every batch that succeeded, keyed by address
+	//   - error: a *PartialError listing the failed batches and their errors, or a *ParamError when no address is given
+	//
+	// Example usage:
+	//   metadata, err := birdeye.TokenMetadataMultiple(ctx, []string{
+	//       "So11111111111111111111111111111111111111112",
+	//       "mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So"})
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token metadata: %v", err)
+	//   }
+	//   fmt.Printf("Token metadata: %+v\n", metadata)
+	TokenMetadataMultiple(ctx context.Context, addresses []string) (TokenMetadataMultiple, error)
+
+	// TokenMarketData retrieves the market data of a token from the Birdeye API,
+	// such as price, liquidity, supply, circulating supply, market cap and FDV.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the token, required
+	//
+	// Returns:
+	//   - BirdeyeResponse[TokenMarketData]: response containing the market data of the token
+	//   - error: a *ParamError when the address is missing, or any error encountered during the API request
+	//
+	// Example usage:
+	//   market, err := birdeye.TokenMarketData(ctx, "So11111111111111111111111111111111111111112")
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token market data: %v", err)
+	//   }
+	//   fmt.Printf("Market cap: %f\n", market.Data.MarketCap)
+	TokenMarketData(ctx context.Context, address string) (result BirdeyeResponse[TokenMarketData], err error)
+
+	// TokenMarketDataMultiple retrieves the market data of any number of tokens using the Birdeye API.
+	// Duplicate addresses are removed, the rest is split into batches of 20 addresses requested
+	// with bounded concurrency, and the results are merged into one map keyed by address.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - addresses: []string - a slice of token addresses of any length
+	//
+	// Returns:
+	//   - TokenMarketDataMultiple: market data of the tokens of every batch that succeeded, keyed by address
+	//   - error: a *PartialError listing the failed batches and their errors, or a *ParamError when no address is given
+	//
+	// Example usage:
+	//   markets, err := birdeye.TokenMarketDataMultiple(ctx, addresses)
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token market data: %v", err)
+	//   }
+	//   fmt.Printf("Token market data: %+v\n", markets)
+	TokenMarketDataMultiple(ctx context.Context, addresses []string) (TokenMarketDataMultiple, error)
+
+	// TokenTradeData retrieves the trading statistics of a token from the Birdeye API.
+	// For every window (Window1m, Window5m, ..., Window24h) it reports buy and sell counts and volumes,
+	// unique wallets and price change, available through TokenTradeData.Window.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the token, required
+	//
+	// Returns:
+	//   - BirdeyeResponse[TokenTradeData]: response containing the trading statistics of the token
+	//   - error: a *ParamError when the address is missing, or any error encountered during the API request
+	//
+	// Example usage:
+	//   trades, err := birdeye.TokenTradeData(ctx, "So11111111111111111111111111111111111111112")
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token trade data: %v", err)
+	//   }
+	//   hour, _ := trades.Data.Window(Window1h)
+	//   fmt.Printf("1h buys: %d, sells: %d\n", hour.Buy, hour.Sell)
+	TokenTradeData(ctx context.Context, address string) (result BirdeyeResponse[TokenTradeData], err error)
+
+	// TokenTradeDataMultiple retrieves the trading statistics of any number of tokens using the Birdeye API.
+	// Duplicate addresses are removed, the rest is split into batches of 20 addresses requested
+	// with bounded concurrency, and the results are merged into one map keyed by address.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - addresses: []string - a slice of token addresses of any length
+	//
+	// Returns:
+	//   - TokenTradeDataMultiple: trading statistics of the tokens of every batch that succeeded, keyed by address
+	//   - error: a *PartialError listing the failed batches and their errors, or a *ParamError when no address is given
+	//
+	// Example usage:
+	//   trades, err := birdeye.TokenTradeDataMultiple(ctx, addresses)
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token trade data: %v", err)
+	//   }
+	//   fmt.Printf("Token trade data: %+v\n", trades)
+	TokenTradeDataMultiple(ctx context.Context, addresses []string) (TokenTradeDataMultiple, error)
+
+	// TokenList retrieves a page of tokens from the Birdeye API, filtered and sorted on the server side.
+	// The `TokenListFilter` builder sets the sort field and order, the minimum and maximum liquidity,
+	// market cap and FDV, the minimum holders, volume and trade count, the listing time and the pagination.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - filter: *TokenListFilter - optional filters, sorting and pagination (limit between 1 and 100); nil uses the API defaults
+	//
+	// Returns:
+	//   - BirdeyeResponse[TokenList]: response containing the matching tokens
+	//   - error: a *ParamError when the filter is invalid, or any error encountered during the API request
+	//
+	// Example usage:
+	//   tokens, err := birdeye.TokenList(ctx, NewTokenListFilter().
+	//       Sort(ListSortByVolume24hUSD, SortTypeDesc).
+	//       MinLiquidity(100_000).
+	//       MinHolder(1_000).
+	//       Limit(50))
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token list: %v", err)
+	//   }
+	//   fmt.Printf("Tokens: %+v\n", tokens)
+	TokenList(ctx context.Context, filter *TokenListFilter) (result BirdeyeResponse[TokenList], err error)
+
+	// TokenListScroll returns an iterator over every token matching `filter`, using the scroll variant of the token list endpoint.
+	// Pages of up to 5000 tokens are fetched by following the scroll ID returned by the API until it is exhausted
+	// or the context is cancelled. The offset of the filter is ignored.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - filter: *TokenListFilter - optional filters, sorting and page size (limit between 1 and 5000); nil uses the API defaults
+	//
+	// Returns:
+	//   - iter.Seq2[TokenListItem, error]: sequence of tokens; an invalid filter or a failed request yields its error once and ends the sequence
+	//
+	// Example usage:
+	//   for token, err := range birdeye.TokenListScroll(ctx, NewTokenListFilter().MinMarketCap(1_000_000).Limit(5000)) {
+	//       if err != nil {
+	//           log.Fatalf("failed to scroll token list: %v", err)
+	//       }
+	//       fmt.Printf("Token: %s %s\n", token.Symbol, token.Address)
+	//   }
+	TokenListScroll(ctx context.Context, filter *TokenListFilter) iter.Seq2[TokenListItem, error]
+
+	// TokenHolders retrieves a page of the holders of a token from the Birdeye API, largest holders first.
+	// Each holder reports its owner, token account, raw amount and UI amount.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the token, required
+	//   - page: *Pagination - optional pagination settings (limit between 1 and 100)
+	//
+	// Returns:
+	//   - BirdeyeResponse[TokenHolderList]: response containing a page of holders of the token
+	//   - error: a *ParamError when the address or the pagination is invalid, or any error encountered during the API request
+	//
+	// Example usage:
+	//   holders, err := birdeye.TokenHolders(ctx, "So11111111111111111111111111111111111111112", &Pagination{
+	//       Offset: 0,
+	//       Limit:  100,
+	//   })
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token holders: %v", err)
+	//   }
+	//   fmt.Printf("Token holders: %+v\n", holders)
+	TokenHolders(ctx context.Context, address string, page *Pagination) (result BirdeyeResponse[TokenHolderList], err error)
+
+	// TokenHoldersAll returns an iterator over the holders of a token, walking the pages of TokenHolders transparently.
+	// Iteration stops after `maxHolders` holders (all holders when `maxHolders` is 0), when the API runs out of holders,
+	// or when the context is cancelled. HolderConcentration computes top-N concentration from the collected holders.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - address: string - the address of the token, required
+	//   - maxHolders: int - the maximum number of holders to return, 0 for no limit
+	//
+	// Returns:
+	//   - iter.Seq2[TokenHolder, error]: sequence of holders; a failed request yields its error once and ends the sequence
+	//
+	// Example usage:
+	//   var holders []TokenHolder
+	//   for holder, err := range birdeye.TokenHoldersAll(ctx, "So11111111111111111111111111111111111111112", 1000) {
+	//       if err != nil {
+	//           log.Fatalf("failed to retrieve token holders: %v", err)
+	//       }
+	//       holders = append(holders, holder)
+	//   }
+	//   fmt.Printf("Top 10 hold %.2f%% of the supply\n", HolderConcentration(holders, overview.Data.Supply, 10))
+	TokenHoldersAll(ctx context.Context, address string, maxHolders int) iter.Seq2[TokenHolder, error]
+
+	// TopTraders retrieves the traders of a token with the largest activity over a time frame from the Birdeye API.
+	// Traders are sorted by volume or by number of trades, and optional pagination controls the returned slice.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the token, required
+	//   - timeframe: traderFrame - the time frame of the statistics (TraderFrame30m to TraderFrame24h), required
+	//   - sortBy: traderSort - the field traders are sorted by (TraderSortByVolume or TraderSortByTrade), required
+	//   - sort: sortType - the sorting order (e.g., ascending or descending), required
+	//   - page: *Pagination - optional pagination settings (limit between 1 and 10)
+	//
+	// Returns:
+	//   - BirdeyeResponse[TopTraderList]: response containing the top traders of the token
+	//   - error: a *ParamError when a parameter is missing or out of range, or any error encountered during the API request
+	//
+	// Example usage:
+	//   traders, err := birdeye.TopTraders(ctx, "So11111111111111111111111111111111111111112",
+	//       TraderFrame24h, TraderSortByVolume, SortTypeDesc, &Pagination{
+	//       Offset: 0,
+	//       Limit:  10,
+	//   })
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve top traders: %v", err)
+	//   }
+	//   fmt.Printf("Top traders: %+v\n", traders)
+	TopTraders(ctx context.Context, address string, timeframe traderFrame, sortBy traderSort, sort sortType, page *Pagination) (result BirdeyeResponse[TopTraderList], err error)
+
+	// TokenMarkets retrieves the markets (pools) a token trades in from the Birdeye API.
+	// Each market reports its address, source DEX, base and quote tokens, liquidity, 24h volume and trade count.
+	// Markets are sorted by liquidity (default) or 24h volume, and paginated with the offset and limit of `opt`.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the token, required
+	//   - opt: *TokenMarketsOpt - optional sorting and pagination settings (limit between 1 and 10)
+	//
+	// Returns:
+	//   - BirdeyeResponse[TokenMarketList]: response containing a page of markets of the token and the total number of markets
+	//   - error: a *ParamError when a parameter is missing or out of range, or any error encountered during the API request
+	//
+	// Example usage:
+	//   markets, err := birdeye.TokenMarkets(ctx, "So11111111111111111111111111111111111111112", &TokenMarketsOpt{
+	//       SortBy:     MarketSortByVolume24h,
+	//       SortType:   SortTypeDesc,
+	//       Pagination: Pagination{Offset: 0, Limit: 10},
+	//   })
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve token markets: %v", err)
+	//   }
+	//   fmt.Printf("Token markets: %+v\n", markets)
+	TokenMarkets(ctx context.Context, address string, opt *TokenMarketsOpt) (result BirdeyeResponse[TokenMarketList], err error)
+
+	// Pair APIs
+
+	// PairOverview retrieves the overview of a trading pair or pool from the Birdeye API.
+	// The overview includes the base and quote tokens, the source DEX, price, liquidity and, for every window
+	// (Window30m, Window1h, ..., Window24h), price change, volume, trade count and unique wallets.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API request
+	//   - address: string - the address of the pair, required
+	//
+	// Returns:
+	//   - BirdeyeResponse[PairOverview]: response containing the overview of the pair
+	//   - error: a *ParamError when the address is missing, or any error encountered during the API request
+	//
+	// Example usage:
+	//   pair, err := birdeye.PairOverview(ctx, "Czfq3xZZDmsdGdUyrNLtRhGc47cXcZtLG4crryfu44zE")
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve pair overview: %v", err)
+	//   }
+	//   day, _ := pair.Data.Window(Window24h)
+	//   fmt.Printf("%s/%s liquidity: %f, 24h volume: %f\n", pair.Data.Base.Symbol, pair.Data.Quote.Symbol, pair.Data.Liquidity, day.Volume)
+	PairOverview(ctx context.Context, address string) (result BirdeyeResponse[PairOverview], err error)
+
+	// PairOverviewMultiple retrieves the overview of any number of trading pairs using the Birdeye API.
+	// Duplicate addresses are removed, the rest is split into batches of 20 addresses requested
+	// with bounded concurrency, and the results are merged into one map keyed by address.
+	//
+	// Parameters:
+	//   - ctx: context.Context - used to control the lifecycle of the API requests
+	//   - addresses: []string - a slice of pair addresses of any length
+	//
+	// Returns:
+	//   - PairOverviewMultiple: overviews of the pairs of every batch that succeeded, keyed by address
+	//   - error: a *PartialError listing the failed batches and their errors, or a *ParamError when no address is given
+	//
+	// Example usage:
+	//   pairs, err := birdeye.PairOverviewMultiple(ctx, []string{
+	//       "Czfq3xZZDmsdGdUyrNLtRhGc47cXcZtLG4crryfu44zE",
+	//       "58oQChx4yWmvKdwLLZzBi4ChoCc2fqCUWBkwMihLYQo2"})
+	//   if err != nil {
+	//       log.Fatalf("failed to retrieve pair overviews: %v", err)
+	//   }
+	//   fmt.Printf("Pair overviews: %+v\n", pairs)
+	PairOverviewMultiple(ctx context.Context, addresses []string) (PairOverviewMultiple, error)
 }
@@ -1,7 +1,9 @@
 package birdeye
 
 import (
+	"context"
 	"net/http"
+	"net/url"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/pkg/errors"
@@ -12,25 +14,88 @@ var (
 )
 
 type birdeye struct {
-	client *resty.Client
+	client  *resty.Client
+	chain   chain
+	retry   RetryPolicy
+	limiter *limiter
+	// configErr is an invalid option combination passed to New, returned
+	// by every request.
+	configErr error
 }
 
-func New(apiKey string, chain ...chain) Birdeye {
-	client := resty.New().
-		SetBaseURL(baseURL).
-		SetHeader("X-API-KEY", apiKey)
+// New creates a Birdeye client authenticated with apiKey.
+func New(apiKey string, opts ...Option) Birdeye {
+	o := options{
+		baseURL: baseURL,
+		retry:   DefaultRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt.apply(&o)
+	}
+
+	client := resty.New()
+	if o.httpClient != nil {
+		// Work on a copy so the options below leave the caller's client,
+		// which may be shared, untouched.
+		hc := *o.httpClient
+		client = resty.NewWithClient(&hc)
+	}
+
+	client.
+		SetBaseURL(o.baseURL).
+		SetHeader("X-API-KEY", apiKey).
+		SetHeaders(o.headers)
+
+	if o.transport != nil {
+		client.SetTransport(o.transport)
+	}
+
+	var configErr error
+	if o.proxy != "" {
+		configErr = setProxy(client, o.proxy)
+	}
 
-	if len(chain) > 0 {
-		client.SetHeader("x-chain", string(chain[0]))
+	if o.timeout > 0 {
+		client.SetTimeout(o.timeout)
+	}
+
+	if o.userAgent != "" {
+		client.SetHeader("User-Agent", o.userAgent)
 	}
 
 	return &birdeye{
-		client: client,
+		client:  client,
+		chain:   o.chain,
+		retry:   o.retry,
+		limiter: newLimiter(o.rateLimit),
+
+		configErr: configErr,
 	}
 }
 
+// setProxy routes client through proxyURL using a copy of its transport, so
+// a transport shared with other clients is left untouched.
+func setProxy(client *resty.Client, proxyURL string) error {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return invalidParam("proxy", "%v", err)
+	}
+
+	transport, ok := client.GetClient().Transport.(*http.Transport)
+	if !ok {
+		return invalidParam("proxy", "requires an *http.Transport, got %T", client.GetClient().Transport)
+	}
+
+	transport = transport.Clone()
+	transport.Proxy = http.ProxyURL(u)
+	client.SetTransport(transport)
+	return nil
+}
+
+// SetXChain changes the default chain. It must not be called while requests
+// are in flight; use ContextWithChain to query several chains concurrently.
 func (b *birdeye) SetXChain(xChain chain) *birdeye {
-	b.client.SetHeader("x-chain", string(xChain))
+	b.chain = xChain
 	return b
 }
 
@@ -39,7 +104,51 @@ func (b *birdeye) SetAPIKey(apiKey string) *birdeye {
 	return b
 }
 
+type chainKey struct{}
+
+// ContextWithChain returns a copy of ctx that makes every request sent with
+// it target chain c instead of the client default. It is the way to query
+// several chains concurrently through one client.
+func ContextWithChain(ctx context.Context, c chain) context.Context {
+	return context.WithValue(ctx, chainKey{}, c)
+}
+
+// chainFor returns the chain a request sent with ctx targets.
+func (b *birdeye) chainFor(ctx context.Context) chain {
+	if c, ok := ctx.Value(chainKey{}).(chain); ok && c != "" {
+		return c
+	}
+	return b.chain
+}
+
+// call executes req, retrying it according to the client retry policy.
 func (b *birdeye) call(req *resty.Request, method string, path string) (err error) {
+	if b.configErr != nil {
+		return b.configErr
+	}
+
+	ctx := req.Context()
+	if c := b.chainFor(ctx); c != "" {
+		req.SetHeader("x-chain", string(c))
+	}
+
+	for attempt := 1; ; attempt++ {
+		if err := b.limiter.wait(ctx, path); err != nil {
+			return errors.Wrap(err, "Birdeye: rate limiter")
+		}
+
+		err = b.do(req, method, path)
+		if err == nil || !b.retry.shouldRetry(ctx, method, attempt, err) {
+			return err
+		}
+
+		if sleep(ctx, b.retry.backoff(attempt, err)) != nil {
+			return err
+		}
+	}
+}
+
+func (b *birdeye) do(req *resty.Request, method string, path string) (err error) {
 	var resp *resty.Response
 	switch method {
 	case http.MethodGet:
@@ -52,8 +161,8 @@ func (b *birdeye) call(req *resty.Request, method string, path string) (err erro
 		return errors.Wrap(err, "Birdeye: failed to make request")
 	}
 
-	if resp.IsError() {
-		return errors.Errorf("Birdeye: request failed with status code: %d and body: %s", resp.StatusCode(), resp.String())
+	if apiErr := newAPIError(resp, method, path); apiErr != nil {
+		return apiErr
 	}
 
 	return nil
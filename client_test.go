@@ -0,0 +1,56 @@
+package birdeye_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	birdeye "github.com/Dzirael/birdeye-go"
+)
+
+// TestContextWithChainConcurrent sends requests for two chains through one
+// client concurrently. Run it with -race.
+func TestContextWithChainConcurrent(t *testing.T) {
+	prices := map[string]float64{"solana": 1, "base": 2}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		price, ok := prices[r.Header.Get("x-chain")]
+		if !ok {
+			http.Error(w, `{"success":false,"message":"unknown chain"}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"success":true,"data":{"value":%v}}`, price)
+	}))
+	defer srv.Close()
+
+	client := birdeye.New("key", birdeye.WithBaseURL(srv.URL), birdeye.WithRetryPolicy(birdeye.NoRetry))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		for _, c := range []struct {
+			ctx  context.Context
+			want float64
+		}{
+			{ctx: birdeye.ContextWithChain(context.Background(), birdeye.Solana), want: prices["solana"]},
+			{ctx: birdeye.ContextWithChain(context.Background(), birdeye.Base), want: prices["base"]},
+		} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := client.Price(c.ctx, "address", nil)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if resp.Data.Value != c.want {
+					t.Errorf("got price %v, want %v", resp.Data.Value, c.want)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+}
@@ -2,8 +2,15 @@ package birdeye
 
 import (
 	"context"
+	"iter"
+	"maps"
 	"net/http"
+	"slices"
 	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 func (b *birdeye) SupportedNetworks(ctx context.Context) (result BirdeyeResponse[SupportedNetworks], err error) {
@@ -42,6 +49,10 @@ func (b *birdeye) Price(ctx context.Context, address string, opt *PriceOpt) (res
 }
 
 func (b *birdeye) PriceMultipleGet(ctx context.Context, addresses []string, opt *PriceOpt) (result BirdeyeResponse[PriceMultiple], err error) {
+	if err := checkRange("addresses", len(addresses), 1, maxMultiPriceAddresses); err != nil {
+		return result, err
+	}
+
 	params := querry{
 		"list_address": toString(addresses),
 	}
@@ -61,6 +72,10 @@ func (b *birdeye) PriceMultipleGet(ctx context.Context, addresses []string, opt
 }
 
 func (b *birdeye) PriceMultiplePost(ctx context.Context, addresses []string, opt *PriceOpt) (result BirdeyeResponse[PriceMultiple], err error) {
+	if err := checkRange("addresses", len(addresses), 1, maxMultiPriceAddresses); err != nil {
+		return result, err
+	}
+
 	params := querry{
 		"list_address": toString(addresses),
 	}
@@ -80,26 +95,159 @@ func (b *birdeye) PriceMultiplePost(ctx context.Context, addresses []string, opt
 	return
 }
 
+// maxMultiPriceAddresses is the number of addresses accepted by one multi price request.
+const maxMultiPriceAddresses = 100
+
+// Optional parameters for Prices
+type PricesOpt struct {
+	PriceOpt
+	// Concurrency is the number of batches requested in parallel. Defaults to 4.
+	Concurrency int
+}
+
+func (b *birdeye) Prices(ctx context.Context, addresses []string, opt *PricesOpt) (PriceMultiple, error) {
+	addresses = dedupe(addresses)
+	if len(addresses) == 0 {
+		return nil, invalidParam("addresses", "at least one address is required")
+	}
+
+	var priceOpt *PriceOpt
+	concurrency := defaultBatchConcurrency
+	if opt != nil {
+		priceOpt = &opt.PriceOpt
+		if opt.Concurrency > 0 {
+			concurrency = opt.Concurrency
+		}
+	}
+
+	var mu sync.Mutex
+	prices := make(PriceMultiple, len(addresses))
+	err := batch(ctx, addresses, maxMultiPriceAddresses, concurrency, func(ctx context.Context, chunk []string) error {
+		resp, err := b.PriceMultipleGet(ctx, chunk, priceOpt)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		maps.Copy(prices, resp.Data)
+		return nil
+	})
+
+	return prices, err
+}
+
 type PriceHistoricalOpt struct {
-	Address     string `json:"address"`
-	AddressType string `json:"address_type"`
-	Type        string `json:"type"`
-	TimeFrom    int    `json:"time_from"`
-	TimeTo      int    `json:"time_to"`
+	Address string
+	// AddressType tells whether Address is a token or a pair. Defaults to AddressTypeToken.
+	AddressType addressType
+	// Type is the interval between two price points.
+	Type     Timeframe
+	TimeFrom time.Time
+	TimeTo   time.Time
 }
 
-func (b *birdeye) PriceHistorical(ctx context.Context, opt PriceHistoricalOpt) (result BirdeyeResponse[Price], err error) {
+func (opt *PriceHistoricalOpt) validate() error {
+	if opt.Address == "" {
+		return invalidParam("PriceHistoricalOpt.Address", "is required")
+	}
+
+	if opt.AddressType == "" {
+		opt.AddressType = AddressTypeToken
+	}
+
+	if opt.AddressType != AddressTypeToken && opt.AddressType != AddressTypePair {
+		return invalidParam("PriceHistoricalOpt.AddressType", "unsupported address type %q", opt.AddressType)
+	}
+
+	if opt.Type.Duration() == 0 {
+		return invalidParam("PriceHistoricalOpt.Type", "unsupported interval %q", opt.Type)
+	}
+
+	return checkTimeRange("PriceHistoricalOpt.TimeFrom/TimeTo", opt.TimeFrom, opt.TimeTo)
+}
+
+func (b *birdeye) PriceHistorical(ctx context.Context, opt PriceHistoricalOpt) (result BirdeyeResponse[PriceHistory], err error) {
+	if err := opt.validate(); err != nil {
+		return result, err
+	}
+
+	params := querry{
+		"address":      opt.Address,
+		"address_type": string(opt.AddressType),
+		"type":         string(opt.Type),
+		"time_from":    strconv.FormatInt(opt.TimeFrom.Unix(), 10),
+		"time_to":      strconv.FormatInt(opt.TimeTo.Unix(), 10),
+	}
+
 	req := b.client.R().
-		SetBody(opt).
+		SetQueryParams(params).
 		SetContext(ctx).
 		SetResult(&result)
 
-	err = b.call(req, http.MethodGet, "/birdeye/history_price")
+	err = b.call(req, http.MethodGet, "/defi/history_price")
 	return
 }
 
+// maxPricePoints is the number of points Birdeye returns at most per history_price request.
+const maxPricePoints = 1000
+
+// Optional parameters for PriceHistoricalRange
+type PriceHistoricalRangeOpt struct {
+	// Concurrency is the number of windows fetched in parallel. Defaults to 1.
+	Concurrency int
+}
+
+func (b *birdeye) PriceHistoricalRange(ctx context.Context, opt PriceHistoricalOpt, rangeOpt *PriceHistoricalRangeOpt) (history PriceHistory, err error) {
+	if err := opt.validate(); err != nil {
+		return history, err
+	}
+
+	concurrency := 1
+	if rangeOpt != nil && rangeOpt.Concurrency > 0 {
+		concurrency = rangeOpt.Concurrency
+	}
+
+	// Windows starting in the future would be rejected by PriceHistorical.
+	if now := time.Now(); opt.TimeTo.After(now) {
+		opt.TimeTo = now
+	}
+
+	windows := splitRange(opt.TimeFrom, opt.TimeTo, opt.Type.Duration()*maxPricePoints)
+	pages := make([][]PricePoint, len(windows))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := runConcurrent(ctx, len(windows), concurrency, func(ctx context.Context, i int) error {
+		window := opt
+		window.TimeFrom, window.TimeTo = windows[i][0], windows[i][1]
+
+		resp, err := b.PriceHistorical(ctx, window)
+		if err != nil {
+			cancel()
+			return errors.Wrapf(err, "Birdeye: history_price window %s - %s", windows[i][0], windows[i][1])
+		}
+		pages[i] = resp.Data.Items
+		return nil
+	})
+	if err := firstError(errs); err != nil {
+		return history, err
+	}
+
+	points := slices.Concat(pages...)
+	slices.SortFunc(points, func(a, b PricePoint) int {
+		return a.UnixTime.Compare(b.UnixTime.Time)
+	})
+	history.Items = slices.CompactFunc(points, func(a, b PricePoint) bool {
+		return a.UnixTime.Equal(b.UnixTime.Time)
+	})
+	return history, nil
+}
+
 type PriceHistoricalUnixOpt struct {
-	UnixTime int
+	// Time is the moment the price is requested for. Defaults to now.
+	Time time.Time
 }
 
 func (b *birdeye) PriceHistoricalUnix(ctx context.Context, address string, opt *PriceHistoricalUnixOpt) (result BirdeyeResponse[PriceHistoricalUnix], err error) {
@@ -107,8 +255,11 @@ func (b *birdeye) PriceHistoricalUnix(ctx context.Context, address string, opt *
 		"address": address,
 	}
 
-	if opt != nil {
-		params["unixtime"] = strconv.Itoa(opt.UnixTime)
+	if opt != nil && !opt.Time.IsZero() {
+		if err := checkTime("PriceHistoricalUnixOpt.Time", opt.Time); err != nil {
+			return result, err
+		}
+		params["unixtime"] = strconv.FormatInt(opt.Time.Unix(), 10)
 	}
 
 	req := b.client.R().
@@ -125,14 +276,31 @@ type Pagination struct {
 	Limit  int
 }
 
-func (b *birdeye) TokenTrades(ctx context.Context, address, tx_type string, sort sortType, opt *Pagination) (result BirdeyeResponse[Trade], err error) {
+func (b *birdeye) TokenTrades(ctx context.Context, address, tx_type string, sort sortType, opt *Pagination) (result BirdeyeResponse[TradeList], err error) {
+	return b.trades(ctx, "/birdeye/txs/token", address, tx_type, sort, opt)
+}
+
+func (b *birdeye) PairTrades(ctx context.Context, address, tx_type string, sort sortType, opt *Pagination) (result BirdeyeResponse[TradeList], err error) {
+	return b.trades(ctx, "/defi/txs/pair", address, tx_type, sort, opt)
+}
+
+func (b *birdeye) trades(ctx context.Context, path, address, txType string, sort sortType, opt *Pagination) (result BirdeyeResponse[TradeList], err error) {
 	params := querry{
 		"address":   address,
-		"tx_type":   tx_type,
+		"tx_type":   txType,
 		"sort_type": string(sort),
 	}
 
 	if opt != nil {
+		if opt.Offset < 0 {
+			return result, invalidParam("Pagination.Offset", "must not be negative, got %d", opt.Offset)
+		}
+		if err := checkRange("Pagination.Limit", opt.Limit, 1, maxTradesLimit); err != nil {
+			return result, err
+		}
+		if opt.Offset+opt.Limit > maxTradesOffset {
+			return result, invalidParam("Pagination.Offset", "offset + limit must not exceed %d, got %d", maxTradesOffset, opt.Offset+opt.Limit)
+		}
 		params["offset"] = strconv.Itoa(opt.Offset)
 		params["limit"] = strconv.Itoa(opt.Limit)
 	}
@@ -142,27 +310,251 @@ func (b *birdeye) TokenTrades(ctx context.Context, address, tx_type string, sort
 		SetContext(ctx).
 		SetResult(&result)
 
-	err = b.call(req, http.MethodGet, "/birdeye/txs/token")
+	err = b.call(req, http.MethodGet, path)
 	return
 }
 
-func (b *birdeye) PairTrades(ctx context.Context, address, tx_type string, sort sortType, opt *Pagination) (result BirdeyeResponse[Trade], err error) {
+const (
+	// maxTradesLimit is the largest page size accepted by the trades endpoints.
+	maxTradesLimit = 50
+	// maxTradesOffset is the deepest offset + limit the trades endpoints serve.
+	maxTradesOffset = 50000
+)
+
+// Optional parameters for TokenTradesAll and PairTradesAll
+type TradesIterOpt struct {
+	// PageSize is the number of trades fetched per request, between 1 and 50. Defaults to 50.
+	PageSize int
+	// Until stops the iteration at the first trade older than Until when
+	// sorting descending, or newer than Until when sorting ascending.
+	Until time.Time
+}
+
+func (b *birdeye) TokenTradesAll(ctx context.Context, address, tx_type string, sort sortType, opt *TradesIterOpt) iter.Seq2[Trade, error] {
+	return b.tradesAll(ctx, "/birdeye/txs/token", address, tx_type, sort, opt)
+}
+
+func (b *birdeye) PairTradesAll(ctx context.Context, address, tx_type string, sort sortType, opt *TradesIterOpt) iter.Seq2[Trade, error] {
+	return b.tradesAll(ctx, "/defi/txs/pair", address, tx_type, sort, opt)
+}
+
+// tradesAll walks the trades served by path page by page until the API
+// reports no more pages, the maximum offset is reached or the cutoff of
+// opt.Until is crossed. A failed request ends the sequence with its error.
+func (b *birdeye) tradesAll(ctx context.Context, path, address, txType string, sort sortType, opt *TradesIterOpt) iter.Seq2[Trade, error] {
+	pageSize := maxTradesLimit
+	var until time.Time
+	if opt != nil {
+		if opt.PageSize != 0 {
+			pageSize = opt.PageSize
+		}
+		until = opt.Until
+	}
+
+	return func(yield func(Trade, error) bool) {
+		if err := checkRange("TradesIterOpt.PageSize", pageSize, 1, maxTradesLimit); err != nil {
+			yield(Trade{}, err)
+			return
+		}
+
+		for offset := 0; offset < maxTradesOffset; offset += pageSize {
+			if err := ctx.Err(); err != nil {
+				yield(Trade{}, err)
+				return
+			}
+
+			result, err := b.trades(ctx, path, address, txType, sort, &Pagination{
+				Offset: offset,
+				Limit:  min(pageSize, maxTradesOffset-offset),
+			})
+			if err != nil {
+				yield(Trade{}, err)
+				return
+			}
+
+			for _, trade := range result.Data.Items {
+				if !until.IsZero() && pastCutoff(trade.BlockUnixTime.Time, until, sort) {
+					return
+				}
+				if !yield(trade, nil) {
+					return
+				}
+			}
+
+			if !result.Data.HasNext || len(result.Data.Items) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// pastCutoff reports whether t lies beyond until in the direction of sort.
+func pastCutoff(t, until time.Time, sort sortType) bool {
+	if sort == SortTypeAsc {
+		return t.After(until)
+	}
+	return t.Before(until)
+}
+
+func (b *birdeye) OHLCV(ctx context.Context, address string, timeframe Timeframe, from, to time.Time) (result BirdeyeResponse[OHLCV], err error) {
+	return b.ohlcv(ctx, "/defi/ohlcv", querry{"address": address}, timeframe, from, to)
+}
+
+func (b *birdeye) PairOHLCV(ctx context.Context, address string, timeframe Timeframe, from, to time.Time) (result BirdeyeResponse[OHLCV], err error) {
+	return b.ohlcv(ctx, "/defi/ohlcv/pair", querry{"address": address}, timeframe, from, to)
+}
+
+func (b *birdeye) BaseQuoteOHLCV(ctx context.Context, baseAddress, quoteAddress string, timeframe Timeframe, from, to time.Time) (result BirdeyeResponse[OHLCV], err error) {
+	if baseAddress == "" || quoteAddress == "" {
+		return result, invalidParam("baseAddress/quoteAddress", "both addresses are required")
+	}
+
 	params := querry{
-		"address":   address,
-		"tx_type":   tx_type,
-		"sort_type": string(sort),
+		"base_address":  baseAddress,
+		"quote_address": quoteAddress,
 	}
+	return b.ohlcv(ctx, "/defi/ohlcv/base_quote", params, timeframe, from, to)
+}
 
-	if opt != nil {
-		params["offset"] = strconv.Itoa(opt.Offset)
-		params["limit"] = strconv.Itoa(opt.Limit)
+// ohlcv sends a candle request to path after adding the timeframe and the
+// time range to params.
+func (b *birdeye) ohlcv(ctx context.Context, path string, params querry, timeframe Timeframe, from, to time.Time) (result BirdeyeResponse[OHLCV], err error) {
+	if err := validateOHLCV(timeframe, from, to); err != nil {
+		return result, err
 	}
 
+	params["type"] = string(timeframe)
+	params["time_from"] = strconv.FormatInt(from.Unix(), 10)
+	params["time_to"] = strconv.FormatInt(to.Unix(), 10)
+
 	req := b.client.R().
 		SetQueryParams(params).
 		SetContext(ctx).
 		SetResult(&result)
 
-	err = b.call(req, http.MethodGet, "/birdeye/txs/token")
+	err = b.call(req, http.MethodGet, path)
 	return
 }
+
+// validateOHLCV checks the arguments shared by every candle endpoint.
+func validateOHLCV(timeframe Timeframe, from, to time.Time) error {
+	if timeframe.Duration() == 0 {
+		return invalidParam("timeframe", "unsupported timeframe %q", timeframe)
+	}
+
+	return checkTimeOrder("from/to", from, to)
+}
+
+// maxOHLCVCandles is the number of candles Birdeye returns at most per OHLCV request.
+const maxOHLCVCandles = 1000
+
+// Optional parameters for OHLCVRange
+type OHLCVRangeOpt struct {
+	// Concurrency is the number of windows fetched in parallel. Defaults to 1.
+	Concurrency int
+}
+
+func (b *birdeye) OHLCVRange(ctx context.Context, address string, timeframe Timeframe, from, to time.Time, opt *OHLCVRangeOpt) (series OHLCVSeries, err error) {
+	if err := validateOHLCV(timeframe, from, to); err != nil {
+		return series, err
+	}
+
+	concurrency := 1
+	if opt != nil && opt.Concurrency > 0 {
+		concurrency = opt.Concurrency
+	}
+
+	windows := splitRange(from, to, timeframe.Duration()*maxOHLCVCandles)
+	pages := make([][]Candle, len(windows))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := runConcurrent(ctx, len(windows), concurrency, func(ctx context.Context, i int) error {
+		resp, err := b.OHLCV(ctx, address, timeframe, windows[i][0], windows[i][1])
+		if err != nil {
+			cancel()
+			return errors.Wrapf(err, "Birdeye: OHLCV window %s - %s", windows[i][0], windows[i][1])
+		}
+		pages[i] = resp.Data.Items
+		return nil
+	})
+	if err := firstError(errs); err != nil {
+		return series, err
+	}
+
+	return newOHLCVSeries(slices.Concat(pages...), timeframe, from, to), nil
+}
+
+// splitRange splits [from, to) into consecutive windows no longer than size.
+func splitRange(from, to time.Time, size time.Duration) (windows [][2]time.Time) {
+	for start := from; start.Before(to); start = start.Add(size) {
+		end := start.Add(size)
+		if end.After(to) {
+			end = to
+		}
+		windows = append(windows, [2]time.Time{start, end})
+	}
+	return windows
+}
+
+// firstError returns the first error that is not a cancellation caused by
+// another failure, falling back to the first error at all.
+func firstError(errs []error) error {
+	var first error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, context.Canceled) {
+			return err
+		}
+		if first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// newOHLCVSeries sorts candles, drops duplicates and those outside
+// [from, to), and records the gaps between consecutive candles as well as
+// missing data at either end of the range.
+func newOHLCVSeries(candles []Candle, timeframe Timeframe, from, to time.Time) (series OHLCVSeries) {
+	slices.SortFunc(candles, func(a, b Candle) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	step := timeframe.Duration()
+	// Allow half a candle of slack for calendar months.
+	tolerance := step + step/2
+	for _, c := range candles {
+		if c.Time.Before(from) || !c.Time.Before(to) {
+			continue
+		}
+
+		if n := len(series.Candles); n > 0 {
+			prev := series.Candles[n-1].Time
+			if c.Time.Equal(prev) {
+				continue
+			}
+			if c.Time.Sub(prev) > tolerance {
+				series.Gaps = append(series.Gaps, Gap{From: prev.Add(step), To: c.Time})
+			}
+		} else if c.Time.Sub(from) > tolerance {
+			series.Gaps = append(series.Gaps, Gap{From: from, To: c.Time})
+		}
+
+		series.Candles = append(series.Candles, c)
+	}
+
+	if len(series.Candles) == 0 {
+		series.Gaps = append(series.Gaps, Gap{From: from, To: to})
+		return series
+	}
+
+	if end := series.Candles[len(series.Candles)-1].Time.Add(step); to.Sub(end) > tolerance {
+		series.Gaps = append(series.Gaps, Gap{From: end, To: to})
+	}
+
+	return series
+}
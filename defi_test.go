@@ -0,0 +1,99 @@
+package birdeye_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	birdeye "github.com/Dzirael/birdeye-go"
+)
+
+// fixtureServer answers every request with the content of testdata/name and
+// records the requested path.
+func fixtureServer(t *testing.T, name string, path *string) *httptest.Server {
+	t.Helper()
+
+	body, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// checkTradeList compares list against the raw content of testdata/name.
+func checkTradeList(t *testing.T, name string, list birdeye.TradeList) {
+	t.Helper()
+
+	body, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw struct {
+		Data struct {
+			Items   []map[string]any `json:"items"`
+			HasNext bool             `json:"hasNext"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(raw.Data.Items) == 0 {
+		t.Fatalf("fixture %s has no items", name)
+	}
+	if len(list.Items) != len(raw.Data.Items) || list.HasNext != raw.Data.HasNext {
+		t.Fatalf("got %d items, hasNext %v, want %d items, hasNext %v", len(list.Items), list.HasNext, len(raw.Data.Items), raw.Data.HasNext)
+	}
+
+	for i, trade := range list.Items {
+		if trade.TxHash != raw.Data.Items[i]["txHash"] {
+			t.Errorf("item %d: txHash %q, want %q", i, trade.TxHash, raw.Data.Items[i]["txHash"])
+		}
+		if trade.BlockUnixTime.IsZero() {
+			t.Errorf("item %d: blockUnixTime not decoded", i)
+		}
+	}
+}
+
+func TestTokenTradesFixture(t *testing.T) {
+	var path string
+	srv := fixtureServer(t, "txs_token.json", &path)
+	client := birdeye.New("key", birdeye.WithBaseURL(srv.URL))
+
+	resp, err := client.TokenTrades(context.Background(), "So11111111111111111111111111111111111111112", "swap", birdeye.SortTypeDesc, &birdeye.Pagination{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/birdeye/txs/token" {
+		t.Errorf("requested %s, want /birdeye/txs/token", path)
+	}
+	checkTradeList(t, "txs_token.json", resp.Data)
+}
+
+func TestPairTradesFixture(t *testing.T) {
+	var path string
+	srv := fixtureServer(t, "txs_pair.json", &path)
+	client := birdeye.New("key", birdeye.WithBaseURL(srv.URL))
+
+	resp, err := client.PairTrades(context.Background(), "58oQChx4yWmvKdwLLZzBi4ChoCc2fqCUWBkwMihLYQo2", "swap", birdeye.SortTypeDesc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/defi/txs/pair" {
+		t.Errorf("requested %s, want /defi/txs/pair", path)
+	}
+	checkTradeList(t, "txs_pair.json", resp.Data)
+}
@@ -0,0 +1,214 @@
+package birdeye
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
+)
+
+// Sentinel errors matched by *APIError through errors.Is.
+var (
+	ErrInvalidParam = errors.New("birdeye: invalid parameter")
+	ErrUnauthorized = errors.New("birdeye: unauthorized")
+	ErrForbidden    = errors.New("birdeye: forbidden")
+	ErrNotFound     = errors.New("birdeye: not found")
+	ErrRateLimited  = errors.New("birdeye: rate limited")
+	ErrServer       = errors.New("birdeye: server error")
+)
+
+// APIError is returned when Birdeye answers with a non-2xx status code
+// or with a body reporting `success: false`.
+type APIError struct {
+	StatusCode int
+	Success    bool
+	Message    string
+	Method     string
+	Path       string
+	Chain      string
+	RequestID  string
+	Body       string
+	// RetryAfter is the wait requested by the Retry-After header, if any.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.Body
+	}
+	if e.Chain != "" {
+		return fmt.Sprintf("Birdeye: %s %s (chain %s) failed with status code %d: %s", e.Method, e.Path, e.Chain, e.StatusCode, msg)
+	}
+	return fmt.Sprintf("Birdeye: %s %s failed with status code %d: %s", e.Method, e.Path, e.StatusCode, msg)
+}
+
+// Is reports whether the error matches one of the package sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrInvalidParam:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// retryableStatuses are the status codes for which repeating the same
+// request may succeed. DefaultRetryPolicy retries exactly these.
+var retryableStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// Retryable reports whether repeating the same request may succeed.
+func (e *APIError) Retryable() bool {
+	return slices.Contains(retryableStatuses, e.StatusCode)
+}
+
+// IsRetryable reports whether err is an *APIError that may succeed when retried.
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable()
+	}
+	return false
+}
+
+// errorBody is the envelope Birdeye uses to describe failures.
+type errorBody struct {
+	Success *bool  `json:"success"`
+	Message string `json:"message"`
+}
+
+// newAPIError builds an *APIError from resp, or returns nil when the
+// response is a successful one.
+func newAPIError(resp *resty.Response, method, path string) *APIError {
+	var body errorBody
+	_ = json.Unmarshal(resp.Body(), &body)
+
+	if !resp.IsError() && (body.Success == nil || *body.Success) {
+		return nil
+	}
+
+	e := &APIError{
+		StatusCode: resp.StatusCode(),
+		Message:    body.Message,
+		Method:     method,
+		Path:       path,
+		Body:       resp.String(),
+	}
+	if body.Success != nil {
+		e.Success = *body.Success
+	}
+	if resp.Request != nil {
+		e.Chain = resp.Request.Header.Get("x-chain")
+	}
+	e.RequestID = resp.Header().Get("X-Request-Id")
+	if e.RequestID == "" {
+		e.RequestID = resp.Header().Get("Cf-Ray")
+	}
+	e.RetryAfter = parseRetryAfter(resp.Header().Get("Retry-After"))
+	return e
+}
+
+// ParamError reports an argument rejected before any request is sent.
+// It matches ErrInvalidParam through errors.Is.
+type ParamError struct {
+	Param  string
+	Reason string
+}
+
+func (e *ParamError) Error() string {
+	return fmt.Sprintf("Birdeye: invalid parameter %s: %s", e.Param, e.Reason)
+}
+
+func (e *ParamError) Is(target error) bool {
+	return target == ErrInvalidParam
+}
+
+func invalidParam(param, format string, args ...any) *ParamError {
+	return &ParamError{Param: param, Reason: fmt.Sprintf(format, args...)}
+}
+
+// checkRange validates that value lies within [lo, hi].
+func checkRange(param string, value, lo, hi int) error {
+	if value < lo || value > hi {
+		return invalidParam(param, "must be between %d and %d, got %d", lo, hi, value)
+	}
+	return nil
+}
+
+// checkTime rejects zero timestamps and timestamps in the future, which the
+// API answers with an error or an empty result.
+func checkTime(param string, t time.Time) error {
+	if t.IsZero() {
+		return invalidParam(param, "time is required")
+	}
+	if t.After(time.Now()) {
+		return invalidParam(param, "time %s is in the future", t)
+	}
+	return nil
+}
+
+// checkTimeOrder validates that both ends of a range are set and from is
+// before to.
+func checkTimeOrder(param string, from, to time.Time) error {
+	if from.IsZero() || to.IsZero() {
+		return invalidParam(param, "time range is required")
+	}
+	if !from.Before(to) {
+		return invalidParam(param, "from (%s) must be before to (%s)", from, to)
+	}
+	return nil
+}
+
+// checkTimeRange validates a historical range, which must not start in the future.
+func checkTimeRange(param string, from, to time.Time) error {
+	if err := checkTimeOrder(param, from, to); err != nil {
+		return err
+	}
+	return checkTime(param, from)
+}
+
+// PartialError is returned by the batching helpers when some batches
+// failed. The result returned alongside it holds the data of the batches
+// that succeeded.
+type PartialError struct {
+	// Batches is the total number of batches sent.
+	Batches int
+	Failed  []BatchError
+}
+
+// BatchError is the failure of one batch of a batched request.
+type BatchError struct {
+	Items []string
+	Err   error
+}
+
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("Birdeye: %d of %d batches failed, first error: %v", len(e.Failed), e.Batches, e.Failed[0].Err)
+}
+
+// Unwrap exposes the error of every failed batch to errors.Is and errors.As.
+func (e *PartialError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, f := range e.Failed {
+		errs[i] = f.Err
+	}
+	return errs
+}
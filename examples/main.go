@@ -3,13 +3,18 @@ package main
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Dzirael/birdeye-go"
 )
 
 func main() {
 
-	bird := birdeye.New("API_KEY", birdeye.Solana)
+	bird := birdeye.New("API_KEY",
+		birdeye.WithChain(birdeye.Solana),
+		birdeye.WithTimeout(10*time.Second),
+		birdeye.WithRateLimit(birdeye.TierStandard),
+	)
 
 	ctx := context.Background()
 	networks, err := bird.SupportedNetworks(ctx)
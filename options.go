@@ -0,0 +1,93 @@
+package birdeye
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures a client created by New.
+type Option interface {
+	apply(*options)
+}
+
+type options struct {
+	chain      chain
+	baseURL    string
+	httpClient *http.Client
+	transport  http.RoundTripper
+	timeout    time.Duration
+	userAgent  string
+	headers    map[string]string
+	proxy      string
+	retry      RetryPolicy
+	rateLimit  RateLimit
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) apply(o *options) { f(o) }
+
+// apply lets a chain be passed to New directly, e.g. New(apiKey, Solana).
+func (c chain) apply(o *options) { o.chain = c }
+
+// WithChain sets the default x-chain header sent with every request.
+func WithChain(c chain) Option {
+	return c
+}
+
+// WithBaseURL overrides the Birdeye API base URL, e.g. to target a
+// staging environment or a local mock.
+func WithBaseURL(url string) Option {
+	return optionFunc(func(o *options) { o.baseURL = url })
+}
+
+// WithHTTPClient makes the client send requests through a copy of hc, so
+// WithTimeout, WithTransport and WithProxy don't modify hc itself.
+func WithHTTPClient(hc *http.Client) Option {
+	return optionFunc(func(o *options) { o.httpClient = hc })
+}
+
+// WithTransport sets the round tripper used to send requests.
+func WithTransport(rt http.RoundTripper) Option {
+	return optionFunc(func(o *options) { o.transport = rt })
+}
+
+// WithTimeout sets the timeout of a single HTTP attempt.
+func WithTimeout(d time.Duration) Option {
+	return optionFunc(func(o *options) { o.timeout = d })
+}
+
+// WithUserAgent sets the User-Agent header.
+func WithUserAgent(ua string) Option {
+	return optionFunc(func(o *options) { o.userAgent = ua })
+}
+
+// WithHeaders adds headers sent with every request. It can be passed
+// several times; later values win.
+func WithHeaders(headers map[string]string) Option {
+	return optionFunc(func(o *options) {
+		if o.headers == nil {
+			o.headers = make(map[string]string, len(headers))
+		}
+		for k, v := range headers {
+			o.headers[k] = v
+		}
+	})
+}
+
+// WithProxy routes requests through the proxy at url. The transport must be
+// an *http.Transport; with any other transport, or an invalid url, every
+// request fails with a *ParamError.
+func WithProxy(url string) Option {
+	return optionFunc(func(o *options) { o.proxy = url })
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy. Pass NoRetry to disable retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return optionFunc(func(o *options) { o.retry = policy })
+}
+
+// WithRateLimit enables the client side rate limiter, e.g. WithRateLimit(TierStarter).
+func WithRateLimit(rl RateLimit) Option {
+	return optionFunc(func(o *options) { o.rateLimit = rl })
+}
@@ -0,0 +1,27 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+)
+
+func (b *birdeye) PairOverview(ctx context.Context, address string) (result BirdeyeResponse[PairOverview], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	req := b.client.R().
+		SetQueryParam("address", address).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/v3/pair/overview/single")
+	return
+}
+
+// maxPairOverviewAddresses is the number of addresses accepted by one multiple pair overview request.
+const maxPairOverviewAddresses = 20
+
+func (b *birdeye) PairOverviewMultiple(ctx context.Context, addresses []string) (PairOverviewMultiple, error) {
+	return multiple[PairOverview](ctx, b, "/defi/v3/pair/overview/multiple", addresses, maxPairOverviewAddresses)
+}
@@ -0,0 +1,96 @@
+package birdeye
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit configures the client side token bucket shared by every call
+// made through one client.
+type RateLimit struct {
+	// RPS is the number of request units replenished per second.
+	RPS float64
+	// Burst is the number of units that can be spent at once.
+	// Defaults to RPS, with a minimum of 1.
+	Burst int
+	// Weights maps endpoint paths (e.g. "/birdeye/multi_price") to the
+	// number of units a request to that path consumes. Paths that are not
+	// listed cost 1.
+	Weights map[string]int
+}
+
+// Presets matching the Birdeye API subscription tiers.
+var (
+	TierStandard = RateLimit{RPS: 1}
+	TierStarter  = RateLimit{RPS: 15}
+	TierPremium  = RateLimit{RPS: 50}
+	TierBusiness = RateLimit{RPS: 100}
+)
+
+type limiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	weights map[string]int
+}
+
+func newLimiter(rl RateLimit) *limiter {
+	if rl.RPS <= 0 {
+		return nil
+	}
+
+	burst := float64(rl.Burst)
+	if burst <= 0 {
+		burst = max(rl.RPS, 1)
+	}
+
+	weights := make(map[string]int, len(rl.Weights))
+	for path, weight := range rl.Weights {
+		weights[path] = weight
+	}
+
+	return &limiter{
+		rate:    rl.RPS,
+		burst:   burst,
+		tokens:  burst,
+		last:    time.Now(),
+		weights: weights,
+	}
+}
+
+// wait blocks until a request to path may be sent or ctx is done.
+// A nil limiter never blocks.
+func (l *limiter) wait(ctx context.Context, path string) error {
+	if l == nil {
+		return nil
+	}
+
+	n := float64(1)
+	if weight, ok := l.weights[path]; ok && weight > 0 {
+		n = float64(weight)
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens -= n
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	if err := sleep(ctx, delay); err != nil {
+		l.mu.Lock()
+		l.tokens += n
+		l.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
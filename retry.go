@@ -0,0 +1,140 @@
+package birdeye
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy controls how failed requests are repeated.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BaseBackoff is the wait before the second attempt, doubled on every
+	// following attempt.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the computed backoff. A Retry-After header sent by
+	// the API is honored even when it is longer.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0..1) of each backoff that is randomized.
+	Jitter float64
+	// RetryStatuses lists the HTTP status codes that are retried.
+	RetryStatuses []int
+	// RetryNetworkErrors enables retries of connection resets, timeouts
+	// and other transport failures.
+	RetryNetworkErrors bool
+	// RetryPost allows retrying POST requests (the multi price endpoint),
+	// which are not retried by default.
+	RetryPost bool
+}
+
+// DefaultRetryPolicy returns the policy used by New.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:        3,
+		BaseBackoff:        500 * time.Millisecond,
+		MaxBackoff:         10 * time.Second,
+		Jitter:             0.2,
+		RetryStatuses:      slices.Clone(retryableStatuses),
+		RetryNetworkErrors: true,
+	}
+}
+
+// NoRetry is a policy that never repeats a request.
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+func (p RetryPolicy) shouldRetry(ctx context.Context, method string, attempt int, err error) bool {
+	if attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	if method != http.MethodGet && !(method == http.MethodPost && p.RetryPost) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return slices.Contains(p.RetryStatuses, apiErr.StatusCode)
+	}
+
+	return p.RetryNetworkErrors && isNetworkError(err)
+}
+
+// backoff returns the wait before the attempt following `attempt`.
+func (p RetryPolicy) backoff(attempt int, err error) time.Duration {
+	d := p.BaseBackoff << (attempt - 1)
+	if d <= 0 || (p.MaxBackoff > 0 && d > p.MaxBackoff) {
+		d = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		d -= time.Duration(rand.Float64() * p.Jitter * float64(d))
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > d {
+		d = apiErr.RetryAfter
+	}
+
+	return d
+}
+
+// isNetworkError reports whether err is a transport failure, including the
+// per-attempt timeout set by WithTimeout. Cancellation of the caller's
+// context is handled by shouldRetry before this is consulted.
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// parseRetryAfter decodes a Retry-After header holding either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+
+	return 0
+}
+
+// sleep waits for d or until ctx is done. It gives up immediately when the
+// wait would outlive the context deadline.
+func sleep(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -2,8 +2,13 @@ package birdeye
 
 import (
 	"context"
+	"encoding/json"
+	"iter"
+	"maps"
 	"net/http"
+	"slices"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -11,16 +16,41 @@ import (
 
 // Optional query parameters for NewListing
 type NewListingOpt struct {
+	// ToTime is a unix time in seconds used when the toTime argument of
+	// NewListing is zero.
+	//
+	// Deprecated: pass the time to NewListing instead.
 	ToTime int
-	Limit  int
+	// Limit is the number of listings returned, between 1 and 20. Defaults to 10.
+	Limit int
+	// MemePlatformEnabled includes tokens launched on meme platforms (Solana only).
+	MemePlatformEnabled bool
 }
 
 func (b *birdeye) NewListing(ctx context.Context, toTime time.Time, opt *NewListingOpt) (result BirdeyeResponse[NewListing], err error) {
+	if toTime.IsZero() && opt != nil && opt.ToTime != 0 {
+		toTime = time.Unix(int64(opt.ToTime), 0)
+	}
+
+	if err := checkTime("toTime", toTime); err != nil {
+		return result, err
+	}
+
 	params := querry{
-		"time_to": strconv.Itoa(int(toTime.Unix())),
+		"time_to": strconv.FormatInt(toTime.Unix(), 10),
 		"limit":   "10",
 	}
 
+	if opt != nil {
+		if opt.Limit != 0 {
+			if err := checkRange("NewListingOpt.Limit", opt.Limit, 1, 20); err != nil {
+				return result, err
+			}
+			params["limit"] = strconv.Itoa(opt.Limit)
+		}
+		params["meme_platform_enabled"] = strconv.FormatBool(opt.MemePlatformEnabled)
+	}
+
 	req := b.client.R().
 		SetQueryParams(params).
 		SetContext(ctx).
@@ -38,18 +68,26 @@ type TrandingListParam struct {
 }
 
 func (b *birdeye) TrendingList(ctx context.Context, param TrandingListParam) (result BirdeyeResponse[TrendingList], err error) {
-	if param.SortBy == "" || param.SortType == "" {
-		return result, errors.New("missing required parameters: TrandingListParam.SortBy or TrandingListParam.SortType")
+	if param.SortBy == "" {
+		return result, invalidParam("TrandingListParam.SortBy", "is required")
+	}
+
+	if param.SortType == "" {
+		return result, invalidParam("TrandingListParam.SortType", "is required")
 	}
 
 	if param.Offset < 0 {
-		return result, errors.New("invalid parameter: TrandingListParam.Offset")
+		return result, invalidParam("TrandingListParam.Offset", "must not be negative, got %d", param.Offset)
 	}
 
 	if param.Limit == 0 {
 		param.Limit = 10
 	}
 
+	if err := checkRange("TrandingListParam.Limit", param.Limit, 1, 20); err != nil {
+		return result, err
+	}
+
 	req := b.client.R().
 		SetQueryParams(map[string]string{
 			"sort_by":   string(param.SortBy),
@@ -63,3 +101,523 @@ func (b *birdeye) TrendingList(ctx context.Context, param TrandingListParam) (re
 	err = b.call(req, http.MethodGet, "/defi/token_trending")
 	return
 }
+
+func (b *birdeye) TokenOverview(ctx context.Context, address string) (result BirdeyeResponse[TokenOverview], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	req := b.client.R().
+		SetQueryParam("address", address).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/token_overview")
+	return
+}
+
+func (b *birdeye) TokenSecurity(ctx context.Context, address string) (result BirdeyeResponse[TokenSecurity], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	// Birdeye defaults to Solana when no chain is given.
+	c := b.chainFor(ctx)
+	solana := c == Solana || c == ""
+	if !solana && !slices.Contains(evmChains, c) {
+		return result, invalidParam("chain", "token security is not supported on %s", c)
+	}
+
+	var raw BirdeyeResponse[json.RawMessage]
+	req := b.client.R().
+		SetQueryParam("address", address).
+		SetContext(ctx).
+		SetResult(&raw)
+
+	if err = b.call(req, http.MethodGet, "/defi/token_security"); err != nil {
+		return
+	}
+
+	result.Success = raw.Success
+	if solana {
+		result.Data.Solana = new(SolanaTokenSecurity)
+		err = json.Unmarshal(raw.Data, result.Data.Solana)
+	} else {
+		result.Data.EVM = new(EVMTokenSecurity)
+		err = json.Unmarshal(raw.Data, result.Data.EVM)
+	}
+	if err != nil {
+		err = errors.Wrap(err, "Birdeye: failed to decode token security")
+	}
+	return
+}
+
+func (b *birdeye) TokenCreationInfo(ctx context.Context, address string) (result BirdeyeResponse[TokenCreationInfo], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	req := b.client.R().
+		SetQueryParam("address", address).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/token_creation_info")
+	return
+}
+
+func (b *birdeye) TokenMetadata(ctx context.Context, address string) (result BirdeyeResponse[TokenMetadata], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	req := b.client.R().
+		SetQueryParam("address", address).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/v3/token/meta-data/single")
+	return
+}
+
+// maxMetadataAddresses is the number of addresses accepted by one multiple metadata request.
+const maxMetadataAddresses = 50
+
+func (b *birdeye) TokenMetadataMultiple(ctx context.Context, addresses []string) (TokenMetadataMultiple, error) {
+	return multiple[TokenMetadata](ctx, b, "/defi/v3/token/meta-data/multiple", addresses, maxMetadataAddresses)
+}
+
+// multiple requests path for addresses in batches of at most size, passed
+// as list_address, and merges the results keyed by address. Failed batches
+// are reported in a *PartialError.
+func multiple[T any](ctx context.Context, b *birdeye, path string, addresses []string, size int) (map[string]T, error) {
+	addresses = dedupe(addresses)
+	if len(addresses) == 0 {
+		return nil, invalidParam("addresses", "at least one address is required")
+	}
+
+	var mu sync.Mutex
+	merged := make(map[string]T, len(addresses))
+	err := batch(ctx, addresses, size, defaultBatchConcurrency, func(ctx context.Context, chunk []string) error {
+		var result BirdeyeResponse[map[string]T]
+		req := b.client.R().
+			SetQueryParam("list_address", toString(chunk)).
+			SetContext(ctx).
+			SetResult(&result)
+
+		if err := b.call(req, http.MethodGet, path); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		maps.Copy(merged, result.Data)
+		return nil
+	})
+
+	return merged, err
+}
+
+func (b *birdeye) TokenMarketData(ctx context.Context, address string) (result BirdeyeResponse[TokenMarketData], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	req := b.client.R().
+		SetQueryParam("address", address).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/v3/token/market-data")
+	return
+}
+
+// maxMarketDataAddresses is the number of addresses accepted by one multiple market or trade data request.
+const maxMarketDataAddresses = 20
+
+func (b *birdeye) TokenMarketDataMultiple(ctx context.Context, addresses []string) (TokenMarketDataMultiple, error) {
+	return multiple[TokenMarketData](ctx, b, "/defi/v3/token/market-data/multiple", addresses, maxMarketDataAddresses)
+}
+
+func (b *birdeye) TokenTradeData(ctx context.Context, address string) (result BirdeyeResponse[TokenTradeData], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	req := b.client.R().
+		SetQueryParam("address", address).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/v3/token/trade-data/single")
+	return
+}
+
+func (b *birdeye) TokenTradeDataMultiple(ctx context.Context, addresses []string) (TokenTradeDataMultiple, error) {
+	return multiple[TokenTradeData](ctx, b, "/defi/v3/token/trade-data/multiple", addresses, maxMarketDataAddresses)
+}
+
+const (
+	// maxTokenListLimit is the largest page size of the token list endpoint.
+	maxTokenListLimit = 100
+	// maxTokenListScrollLimit is the largest page size of the token list scroll endpoint.
+	maxTokenListScrollLimit = 5000
+)
+
+// TokenListFilter selects and sorts the tokens returned by TokenList and
+// TokenListScroll. Create it with NewTokenListFilter and chain the setters:
+//
+//	NewTokenListFilter().Sort(ListSortByVolume24hUSD, SortTypeDesc).MinLiquidity(100_000).Limit(50)
+type TokenListFilter struct {
+	sortBy   listSortBy
+	sortType sortType
+	offset   int
+	limit    int
+	filters  querry
+}
+
+func NewTokenListFilter() *TokenListFilter {
+	return &TokenListFilter{filters: querry{}}
+}
+
+// Sort orders the tokens by field in the given order.
+func (f *TokenListFilter) Sort(field listSortBy, order sortType) *TokenListFilter {
+	f.sortBy, f.sortType = field, order
+	return f
+}
+
+// Offset skips the first n tokens. It is ignored by TokenListScroll.
+func (f *TokenListFilter) Offset(n int) *TokenListFilter {
+	f.offset = n
+	return f
+}
+
+// Limit sets the page size: 1 to 100 for TokenList, 1 to 5000 for TokenListScroll.
+func (f *TokenListFilter) Limit(n int) *TokenListFilter {
+	f.limit = n
+	return f
+}
+
+func (f *TokenListFilter) MinLiquidity(usd float64) *TokenListFilter {
+	return f.set("min_liquidity", usd)
+}
+
+func (f *TokenListFilter) MaxLiquidity(usd float64) *TokenListFilter {
+	return f.set("max_liquidity", usd)
+}
+
+func (f *TokenListFilter) MinMarketCap(usd float64) *TokenListFilter {
+	return f.set("min_market_cap", usd)
+}
+
+func (f *TokenListFilter) MaxMarketCap(usd float64) *TokenListFilter {
+	return f.set("max_market_cap", usd)
+}
+
+func (f *TokenListFilter) MinFDV(usd float64) *TokenListFilter {
+	return f.set("min_fdv", usd)
+}
+
+func (f *TokenListFilter) MaxFDV(usd float64) *TokenListFilter {
+	return f.set("max_fdv", usd)
+}
+
+func (f *TokenListFilter) MinHolder(n int) *TokenListFilter {
+	return f.set("min_holder", float64(n))
+}
+
+func (f *TokenListFilter) MinVolume24hUSD(usd float64) *TokenListFilter {
+	return f.set("min_volume_24h_usd", usd)
+}
+
+func (f *TokenListFilter) MinTrade24hCount(n int) *TokenListFilter {
+	return f.set("min_trade_24h_count", float64(n))
+}
+
+// ListedAfter keeps the tokens listed at or after t.
+func (f *TokenListFilter) ListedAfter(t time.Time) *TokenListFilter {
+	return f.set("min_recent_listing_time", float64(t.Unix()))
+}
+
+// ListedBefore keeps the tokens listed at or before t.
+func (f *TokenListFilter) ListedBefore(t time.Time) *TokenListFilter {
+	return f.set("max_recent_listing_time", float64(t.Unix()))
+}
+
+func (f *TokenListFilter) set(key string, value float64) *TokenListFilter {
+	if f.filters == nil {
+		f.filters = querry{}
+	}
+	f.filters[key] = strconv.FormatFloat(value, 'f', -1, 64)
+	return f
+}
+
+// tokenListBounds are the min/max filter pairs checked by query.
+var tokenListBounds = [][2]string{
+	{"min_liquidity", "max_liquidity"},
+	{"min_market_cap", "max_market_cap"},
+	{"min_fdv", "max_fdv"},
+	{"min_recent_listing_time", "max_recent_listing_time"},
+}
+
+// query validates the filter and returns its query parameters. A nil
+// filter returns no parameters.
+func (f *TokenListFilter) query(maxLimit int, withOffset bool) (querry, error) {
+	params := querry{}
+	if f == nil {
+		return params, nil
+	}
+
+	for key, value := range f.filters {
+		params[key] = value
+	}
+
+	for _, bound := range tokenListBounds {
+		lo, hasLo := params[bound[0]]
+		hi, hasHi := params[bound[1]]
+		if !hasLo || !hasHi {
+			continue
+		}
+		loValue, _ := strconv.ParseFloat(lo, 64)
+		hiValue, _ := strconv.ParseFloat(hi, 64)
+		if loValue > hiValue {
+			return nil, invalidParam("TokenListFilter."+bound[0], "%s is greater than %s %s", lo, bound[1], hi)
+		}
+	}
+
+	if f.sortBy != "" {
+		params["sort_by"] = string(f.sortBy)
+		params["sort_type"] = string(SortTypeDesc)
+		if f.sortType != "" {
+			params["sort_type"] = string(f.sortType)
+		}
+	}
+
+	if f.limit != 0 {
+		if err := checkRange("TokenListFilter.Limit", f.limit, 1, maxLimit); err != nil {
+			return nil, err
+		}
+		params["limit"] = strconv.Itoa(f.limit)
+	}
+
+	if withOffset && f.offset != 0 {
+		if f.offset < 0 {
+			return nil, invalidParam("TokenListFilter.Offset", "must not be negative, got %d", f.offset)
+		}
+		params["offset"] = strconv.Itoa(f.offset)
+	}
+
+	return params, nil
+}
+
+func (b *birdeye) TokenList(ctx context.Context, filter *TokenListFilter) (result BirdeyeResponse[TokenList], err error) {
+	params, err := filter.query(maxTokenListLimit, true)
+	if err != nil {
+		return result, err
+	}
+
+	req := b.client.R().
+		SetQueryParams(params).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/v3/token/list")
+	return
+}
+
+func (b *birdeye) TokenListScroll(ctx context.Context, filter *TokenListFilter) iter.Seq2[TokenListItem, error] {
+	return func(yield func(TokenListItem, error) bool) {
+		params, err := filter.query(maxTokenListScrollLimit, false)
+		if err != nil {
+			yield(TokenListItem{}, err)
+			return
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(TokenListItem{}, err)
+				return
+			}
+
+			var result BirdeyeResponse[TokenListScrollPage]
+			req := b.client.R().
+				SetQueryParams(params).
+				SetContext(ctx).
+				SetResult(&result)
+
+			if err := b.call(req, http.MethodGet, "/defi/v3/token/list/scroll"); err != nil {
+				yield(TokenListItem{}, err)
+				return
+			}
+
+			for _, item := range result.Data.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if result.Data.NextScrollID == "" || len(result.Data.Items) == 0 {
+				return
+			}
+			params["scroll_id"] = result.Data.NextScrollID
+		}
+	}
+}
+
+// maxHoldersLimit is the largest page size of the token holder endpoint.
+const maxHoldersLimit = 100
+
+func (b *birdeye) TokenHolders(ctx context.Context, address string, page *Pagination) (result BirdeyeResponse[TokenHolderList], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	params := querry{
+		"address": address,
+	}
+
+	if page != nil {
+		if page.Offset < 0 {
+			return result, invalidParam("Pagination.Offset", "must not be negative, got %d", page.Offset)
+		}
+		if err := checkRange("Pagination.Limit", page.Limit, 1, maxHoldersLimit); err != nil {
+			return result, err
+		}
+		params["offset"] = strconv.Itoa(page.Offset)
+		params["limit"] = strconv.Itoa(page.Limit)
+	}
+
+	req := b.client.R().
+		SetQueryParams(params).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/v3/token/holder")
+	return
+}
+
+func (b *birdeye) TokenHoldersAll(ctx context.Context, address string, maxHolders int) iter.Seq2[TokenHolder, error] {
+	return func(yield func(TokenHolder, error) bool) {
+		seen := 0
+		for offset := 0; maxHolders <= 0 || seen < maxHolders; {
+			if err := ctx.Err(); err != nil {
+				yield(TokenHolder{}, err)
+				return
+			}
+
+			limit := maxHoldersLimit
+			if maxHolders > 0 {
+				limit = min(maxHolders-seen, maxHoldersLimit)
+			}
+
+			result, err := b.TokenHolders(ctx, address, &Pagination{Offset: offset, Limit: limit})
+			if err != nil {
+				yield(TokenHolder{}, err)
+				return
+			}
+
+			for _, holder := range result.Data.Items {
+				if maxHolders > 0 && seen >= maxHolders {
+					return
+				}
+				seen++
+				if !yield(holder, nil) {
+					return
+				}
+			}
+
+			if len(result.Data.Items) < limit {
+				return
+			}
+			offset += limit
+		}
+	}
+}
+
+// maxTopTradersLimit is the largest page size of the top traders endpoint.
+const maxTopTradersLimit = 10
+
+func (b *birdeye) TopTraders(ctx context.Context, address string, timeframe traderFrame, sortBy traderSort, sort sortType, page *Pagination) (result BirdeyeResponse[TopTraderList], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	if timeframe == "" || sortBy == "" || sort == "" {
+		return result, invalidParam("timeframe/sortBy/sortType", "are required")
+	}
+
+	params := querry{
+		"address":    address,
+		"time_frame": string(timeframe),
+		"sort_by":    string(sortBy),
+		"sort_type":  string(sort),
+	}
+
+	if page != nil {
+		if page.Offset < 0 {
+			return result, invalidParam("Pagination.Offset", "must not be negative, got %d", page.Offset)
+		}
+		if err := checkRange("Pagination.Limit", page.Limit, 1, maxTopTradersLimit); err != nil {
+			return result, err
+		}
+		params["offset"] = strconv.Itoa(page.Offset)
+		params["limit"] = strconv.Itoa(page.Limit)
+	}
+
+	req := b.client.R().
+		SetQueryParams(params).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/v2/tokens/top_traders")
+	return
+}
+
+// maxMarketsLimit is the largest page size of the markets endpoint.
+const maxMarketsLimit = 10
+
+// Optional parameters for TokenMarkets
+type TokenMarketsOpt struct {
+	SortBy   marketSort
+	SortType sortType
+	Pagination
+}
+
+func (b *birdeye) TokenMarkets(ctx context.Context, address string, opt *TokenMarketsOpt) (result BirdeyeResponse[TokenMarketList], err error) {
+	if address == "" {
+		return result, invalidParam("address", "is required")
+	}
+
+	params := querry{
+		"address":   address,
+		"sort_by":   string(MarketSortByLiquidity),
+		"sort_type": string(SortTypeDesc),
+	}
+
+	if opt != nil {
+		if opt.SortBy != "" {
+			params["sort_by"] = string(opt.SortBy)
+		}
+		if opt.SortType != "" {
+			params["sort_type"] = string(opt.SortType)
+		}
+		if opt.Offset < 0 {
+			return result, invalidParam("TokenMarketsOpt.Offset", "must not be negative, got %d", opt.Offset)
+		}
+		params["offset"] = strconv.Itoa(opt.Offset)
+		if opt.Limit != 0 {
+			if err := checkRange("TokenMarketsOpt.Limit", opt.Limit, 1, maxMarketsLimit); err != nil {
+				return result, err
+			}
+			params["limit"] = strconv.Itoa(opt.Limit)
+		}
+	}
+
+	req := b.client.R().
+		SetQueryParams(params).
+		SetContext(ctx).
+		SetResult(&result)
+
+	err = b.call(req, http.MethodGet, "/defi/v2/markets")
+	return
+}
@@ -1,11 +1,27 @@
 package birdeye
 
+import (
+	"cmp"
+	"encoding/json"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
 type (
-	sortBy     string
-	sortType   string
-	chain      string
-	timeUpdate string
-	querry     map[string]string
+	sortBy      string
+	sortType    string
+	chain       string
+	timeUpdate  string
+	addressType string
+	window      string
+	riskFlag    string
+	listSortBy  string
+	traderFrame string
+	traderSort  string
+	marketSort  string
+	querry      map[string]string
 )
 
 var (
@@ -20,8 +36,106 @@ var (
 	H4  timeUpdate = "4h"
 	H8  timeUpdate = "8h"
 	H24 timeUpdate = "24h"
+
+	AddressTypeToken addressType = "token"
+	AddressTypePair  addressType = "pair"
+)
+
+// Windows over which token statistics (trades, volume, wallets, price
+// change) are aggregated.
+var (
+	Window1m  window = "1m"
+	Window5m  window = "5m"
+	Window30m window = "30m"
+	Window1h  window = "1h"
+	Window2h  window = "2h"
+	Window4h  window = "4h"
+	Window8h  window = "8h"
+	Window24h window = "24h"
 )
 
+// Sort fields of TokenListFilter.
+var (
+	ListSortByLiquidity              listSortBy = "liquidity"
+	ListSortByMarketCap              listSortBy = "market_cap"
+	ListSortByFDV                    listSortBy = "fdv"
+	ListSortByRecentListingTime      listSortBy = "recent_listing_time"
+	ListSortByHolder                 listSortBy = "holder"
+	ListSortByLastTradeUnixTime      listSortBy = "last_trade_unix_time"
+	ListSortByVolume1hUSD            listSortBy = "volume_1h_usd"
+	ListSortByVolume24hUSD           listSortBy = "volume_24h_usd"
+	ListSortByVolume24hChangePercent listSortBy = "volume_24h_change_percent"
+	ListSortByPriceChange1hPercent   listSortBy = "price_change_1h_percent"
+	ListSortByPriceChange24hPercent  listSortBy = "price_change_24h_percent"
+	ListSortByTrade1hCount           listSortBy = "trade_1h_count"
+	ListSortByTrade24hCount          listSortBy = "trade_24h_count"
+)
+
+// Time frames of TopTraders.
+var (
+	TraderFrame30m traderFrame = "30m"
+	TraderFrame1h  traderFrame = "1h"
+	TraderFrame2h  traderFrame = "2h"
+	TraderFrame4h  traderFrame = "4h"
+	TraderFrame6h  traderFrame = "6h"
+	TraderFrame8h  traderFrame = "8h"
+	TraderFrame12h traderFrame = "12h"
+	TraderFrame24h traderFrame = "24h"
+
+	TraderSortByVolume traderSort = "volume"
+	TraderSortByTrade  traderSort = "trade"
+
+	MarketSortByLiquidity marketSort = "liquidity"
+	MarketSortByVolume24h marketSort = "volume24h"
+)
+
+var allWindows = []window{Window1m, Window5m, Window30m, Window1h, Window2h, Window4h, Window8h, Window24h}
+
+// Timeframe is the width of an OHLCV candle.
+type Timeframe string
+
+var (
+	Timeframe1m  Timeframe = "1m"
+	Timeframe3m  Timeframe = "3m"
+	Timeframe5m  Timeframe = "5m"
+	Timeframe15m Timeframe = "15m"
+	Timeframe30m Timeframe = "30m"
+	Timeframe1H  Timeframe = "1H"
+	Timeframe2H  Timeframe = "2H"
+	Timeframe4H  Timeframe = "4H"
+	Timeframe6H  Timeframe = "6H"
+	Timeframe8H  Timeframe = "8H"
+	Timeframe12H Timeframe = "12H"
+	Timeframe1D  Timeframe = "1D"
+	Timeframe3D  Timeframe = "3D"
+	Timeframe1W  Timeframe = "1W"
+	Timeframe1M  Timeframe = "1M"
+)
+
+var timeframeDurations = map[Timeframe]time.Duration{
+	Timeframe1m:  time.Minute,
+	Timeframe3m:  3 * time.Minute,
+	Timeframe5m:  5 * time.Minute,
+	Timeframe15m: 15 * time.Minute,
+	Timeframe30m: 30 * time.Minute,
+	Timeframe1H:  time.Hour,
+	Timeframe2H:  2 * time.Hour,
+	Timeframe4H:  4 * time.Hour,
+	Timeframe6H:  6 * time.Hour,
+	Timeframe8H:  8 * time.Hour,
+	Timeframe12H: 12 * time.Hour,
+	Timeframe1D:  24 * time.Hour,
+	Timeframe3D:  3 * 24 * time.Hour,
+	Timeframe1W:  7 * 24 * time.Hour,
+	Timeframe1M:  30 * 24 * time.Hour,
+}
+
+// Duration returns the (approximate for 1M) length of a candle, or 0 when
+// the timeframe is not supported.
+func (t Timeframe) Duration() time.Duration {
+	return timeframeDurations[t]
+}
+
 var (
 	Solana    chain = "solana"
 	Ethereum  chain = "ethereum"
@@ -35,6 +149,9 @@ var (
 	Sui       chain = "sui"
 )
 
+// evmChains are the chains whose tokens follow the EVM security model.
+var evmChains = []chain{Ethereum, Arbitrum, Avalanche, Bsc, Optimism, Polygon, Base, ZkSync}
+
 // BirdeyeResponse is a generic response from Birdeye API
 type BirdeyeResponse[T any] struct {
 	Data    T    `json:"data"`
@@ -45,83 +162,778 @@ type SupportedNetworks []chain
 
 // https://docs.birdeye.so/reference/get_defi-price
 type Price struct {
-	Value           float64 `json:"value"`
-	UpdateUnixTime  int     `json:"updateUnixTime"`
-	UpdateHumanTime string  `json:"updateHumanTime"`
-	Liquidity       float64 `json:"liquidity"`
+	Value           float64  `json:"value"`
+	UpdateUnixTime  UnixTime `json:"updateUnixTime"`
+	UpdateHumanTime DateTime `json:"updateHumanTime"`
+	Liquidity       float64  `json:"liquidity"`
 }
 
 type PriceMultiple map[string]Price
 
+// https://docs.birdeye.so/reference/get_defi-history-price
+type PriceHistory struct {
+	Items []PricePoint `json:"items"`
+}
+
+type PricePoint struct {
+	UnixTime UnixTime `json:"unixTime"`
+	Value    float64  `json:"value"`
+}
+
 type PriceHistoricalUnix struct {
-	Value          float64 `json:"value"`
-	UpdateUnixTime int     `json:"updateUnixTime"`
-	PriceChange24H float64 `json:"priceChange24h"`
+	Value          float64  `json:"value"`
+	UpdateUnixTime UnixTime `json:"updateUnixTime"`
+	PriceChange24H float64  `json:"priceChange24h"`
+}
+
+// https://docs.birdeye.so/reference/get_defi-txs-token
+// https://docs.birdeye.so/reference/get_defi-txs-pair
+type TradeList struct {
+	Items   []Trade `json:"items"`
+	HasNext bool    `json:"hasNext"`
 }
 
 type Trade struct {
-	Quote         TokenData   `json:"quote"`
-	Base          TokenData   `json:"base"`
-	BasePrice     interface{} `json:"basePrice"`
-	QuotePrice    interface{} `json:"quotePrice"`
-	TxHash        string      `json:"txHash"`
-	Source        string      `json:"source"`
-	BlockUnixTime int         `json:"blockUnixTime"`
-	TxType        string      `json:"txType"`
-	Owner         string      `json:"owner"`
-	Side          string      `json:"side"`
-	Alias         interface{} `json:"alias"`
-	PricePair     float64     `json:"pricePair"`
-	From          TokenData   `json:"from"`
-	To            TokenData   `json:"to"`
-	TokenPrice    interface{} `json:"tokenPrice"`
-	PoolID        string      `json:"poolId"`
+	Quote         TokenData `json:"quote"`
+	Base          TokenData `json:"base"`
+	BasePrice     *Float    `json:"basePrice"`
+	QuotePrice    *Float    `json:"quotePrice"`
+	TxHash        string    `json:"txHash"`
+	Source        string    `json:"source"`
+	BlockUnixTime UnixTime  `json:"blockUnixTime"`
+	TxType        string    `json:"txType"`
+	Owner         string    `json:"owner"`
+	Side          string    `json:"side"`
+	Alias         *string   `json:"alias"`
+	PricePair     float64   `json:"pricePair"`
+	From          TokenData `json:"from"`
+	To            TokenData `json:"to"`
+	TokenPrice    *Float    `json:"tokenPrice"`
+	PoolID        string    `json:"poolId"`
+}
+
+// IsBuy reports whether the trade bought the requested token.
+func (t Trade) IsBuy() bool {
+	return t.Side == "buy"
+}
+
+// USDValue returns the value of the trade in USD, computed from the first
+// leg with a known price. It returns false when no leg is priced.
+func (t Trade) USDValue() (float64, bool) {
+	for _, leg := range []TokenData{t.Quote, t.Base, t.From, t.To} {
+		if v, ok := leg.USDValue(); ok {
+			return v, true
+		}
+	}
+	return 0, false
 }
 
 type TokenData struct {
-	Symbol         string      `json:"symbol"`
-	Decimals       int         `json:"decimals"`
-	Address        string      `json:"address"`
-	Amount         int         `json:"amount"`
-	FeeInfo        interface{} `json:"feeInfo"`
-	UIAmount       float64     `json:"uiAmount"`
-	Price          interface{} `json:"price"`
-	NearestPrice   float64     `json:"nearestPrice"`
-	ChangeAmount   int         `json:"changeAmount"`
-	UIChangeAmount float64     `json:"uiChangeAmount"`
+	Symbol         string   `json:"symbol"`
+	Decimals       int      `json:"decimals"`
+	Address        string   `json:"address"`
+	Amount         Amount   `json:"amount"`
+	FeeInfo        *FeeInfo `json:"feeInfo"`
+	UIAmount       float64  `json:"uiAmount"`
+	Price          *Float   `json:"price"`
+	NearestPrice   float64  `json:"nearestPrice"`
+	ChangeAmount   Amount   `json:"changeAmount"`
+	UIChangeAmount float64  `json:"uiChangeAmount"`
+}
+
+// AmountDecimal returns Amount scaled by Decimals without float rounding.
+func (d TokenData) AmountDecimal() string {
+	return d.Amount.Decimal(d.Decimals)
+}
+
+// ChangeAmountDecimal returns ChangeAmount scaled by Decimals without float rounding.
+func (d TokenData) ChangeAmountDecimal() string {
+	return d.ChangeAmount.Decimal(d.Decimals)
+}
+
+// USDValue returns the USD value of the traded amount, using NearestPrice
+// when Price is missing. It returns false when no price is known.
+func (d TokenData) USDValue() (float64, bool) {
+	price := d.NearestPrice
+	if d.Price != nil {
+		price = d.Price.Float64()
+	}
+	if price == 0 {
+		return 0, false
+	}
+
+	amount := d.UIAmount
+	if amount == 0 {
+		amount = d.UIChangeAmount
+	}
+	return math.Abs(amount) * price, true
+}
+
+// FeeInfo describes the token-2022 transfer fee charged on a trade leg.
+type FeeInfo struct {
+	TransferFeeBasisPoints *Float `json:"transferFeeBasisPoints"`
+	MaximumFee             Amount `json:"maximumFee"`
 }
 
 // https://docs.birdeye.so/reference/get_defi-token-trending
 type TrendingList struct {
-	UpdateUnixTime int     `json:"updateUnixTime"`
-	UpdateTime     string  `json:"updateTime"`
-	Tokens         []token `json:"tokens"`
-	Total          int     `json:"total"`
+	UpdateUnixTime UnixTime `json:"updateUnixTime"`
+	UpdateTime     DateTime `json:"updateTime"`
+	Tokens         []token  `json:"tokens"`
+	Total          int      `json:"total"`
 }
 
 type token struct {
-	Address      string  `json:"address"`
-	Decimals     int     `json:"decimals"`
+	TokenRef
+	trendingStats
+}
+
+type trendingStats struct {
 	Liquidity    float64 `json:"liquidity"`
-	LogoURI      string  `json:"logoURI"`
-	Name         string  `json:"name"`
-	Symbol       string  `json:"symbol"`
 	Volume24HUSD float64 `json:"volume24hUSD"`
 	Rank         int     `json:"rank"`
 	Price        float64 `json:"price"`
 }
 
+// UnmarshalJSON decodes the token and its statistics separately, as the
+// promoted TokenRef.UnmarshalJSON would otherwise skip the latter. Both
+// marshal back flat, under the keys they were decoded from.
+func (t *token) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &t.TokenRef); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &t.trendingStats)
+}
+
 // https://docs.birdeye.so/reference/get_defi-v2-tokens-new-listing
 type NewListing struct {
 	Items []listingItem `json:"items"`
 }
 
 type listingItem struct {
-	Address          string      `json:"address"`
-	Symbol           string      `json:"symbol"`
-	Name             string      `json:"name"`
-	Decimals         int         `json:"decimals"`
-	LiquidityAddedAt string      `json:"liquidityAddedAt"`
-	LogoURI          interface{} `json:"logoURI"`
-	Liquidity        float64     `json:"liquidity"`
+	TokenRef
+	listingStats
+}
+
+type listingStats struct {
+	LiquidityAddedAt DateTime `json:"liquidityAddedAt"`
+	Liquidity        float64  `json:"liquidity"`
+}
+
+func (l *listingItem) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &l.TokenRef); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &l.listingStats)
+}
+
+// https://docs.birdeye.so/reference/get_defi-ohlcv
+// https://docs.birdeye.so/reference/get_defi-ohlcv-pair
+// https://docs.birdeye.so/reference/get_defi-ohlcv-base-quote
+type OHLCV struct {
+	Items []Candle `json:"items"`
+}
+
+// Candle is shared by the token, pair and base/quote OHLCV endpoints.
+// Base/quote candles report their volume in BaseVolume and QuoteVolume
+// and leave Volume empty.
+type Candle struct {
+	Open        float64
+	High        float64
+	Low         float64
+	Close       float64
+	Volume      float64
+	BaseVolume  float64
+	QuoteVolume float64
+	Time        time.Time
 }
+
+func (c *Candle) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		O        float64 `json:"o"`
+		H        float64 `json:"h"`
+		L        float64 `json:"l"`
+		C        float64 `json:"c"`
+		V        float64 `json:"v"`
+		VBase    float64 `json:"vBase"`
+		VQuote   float64 `json:"vQuote"`
+		UnixTime int64   `json:"unixTime"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*c = Candle{
+		Open:        raw.O,
+		High:        raw.H,
+		Low:         raw.L,
+		Close:       raw.C,
+		Volume:      raw.V,
+		BaseVolume:  raw.VBase,
+		QuoteVolume: raw.VQuote,
+		Time:        time.Unix(raw.UnixTime, 0),
+	}
+	return nil
+}
+
+// OHLCVSeries is a sorted, de-duplicated list of candles built by OHLCVRange.
+type OHLCVSeries struct {
+	Candles []Candle
+	// Gaps lists the intervals of the requested range for which the API
+	// returned no data, including its start and end and the whole range
+	// when no candle came back.
+	Gaps []Gap
+}
+
+// Gap is a missing interval [From, To) in a candle series.
+type Gap struct {
+	From time.Time
+	To   time.Time
+}
+
+// https://docs.birdeye.so/reference/get_defi-token-overview
+type TokenOverview struct {
+	Address            string          `json:"address"`
+	Decimals           int             `json:"decimals"`
+	Symbol             string          `json:"symbol"`
+	Name               string          `json:"name"`
+	Extensions         TokenExtensions `json:"extensions"`
+	LogoURI            string          `json:"logoURI"`
+	Liquidity          float64         `json:"liquidity"`
+	LastTradeUnixTime  UnixTime        `json:"lastTradeUnixTime"`
+	LastTradeHumanTime DateTime        `json:"lastTradeHumanTime"`
+	Price              float64         `json:"price"`
+	Supply             float64         `json:"supply"`
+	CirculatingSupply  float64         `json:"circulatingSupply"`
+	MarketCap          float64         `json:"marketCap"`
+	RealMarketCap      float64         `json:"realMc"`
+	FDV                float64         `json:"fdv"`
+	Holder             int             `json:"holder"`
+	NumberMarkets      int             `json:"numberMarkets"`
+	windowed
+}
+
+func (t *TokenOverview) UnmarshalJSON(data []byte) error {
+	type plain TokenOverview
+	if err := json.Unmarshal(data, (*plain)(t)); err != nil {
+		return err
+	}
+	return t.windowed.decode(data, false)
+}
+
+// windowed holds the per-window statistics a response spreads over flat
+// keys such as v24hUSD or volume_24h_usd. Types embedding it decode them
+// from their own UnmarshalJSON.
+type windowed struct {
+	// Windows holds the statistics of every window reported by the API.
+	Windows map[window]WindowStats `json:"-"`
+}
+
+// decode collects the windows of data, whose keys are snake_case (v3
+// endpoints) or camelCase.
+func (w *windowed) decode(data []byte, snake bool) error {
+	stats, err := decodeWindows(data, snake)
+	if err != nil {
+		return err
+	}
+	w.Windows = stats
+	return nil
+}
+
+// Window returns the statistics of window win, and false when the API did
+// not report it.
+func (w windowed) Window(win window) (WindowStats, bool) {
+	stats, ok := w.Windows[win]
+	return stats, ok
+}
+
+// WindowStats are the trading statistics of a token over one window. The
+// History fields hold the value of the previous window of the same length.
+type WindowStats struct {
+	HistoryPrice       float64
+	PriceChangePercent float64
+
+	UniqueWallet              int
+	UniqueWalletHistory       int
+	UniqueWalletChangePercent float64
+
+	Trade              int
+	TradeHistory       int
+	TradeChangePercent float64
+	Buy                int
+	BuyHistory         int
+	BuyChangePercent   float64
+	Sell               int
+	SellHistory        int
+	SellChangePercent  float64
+
+	Volume                  float64
+	VolumeUSD               float64
+	VolumeHistory           float64
+	VolumeHistoryUSD        float64
+	VolumeChangePercent     float64
+	VolumeBuy               float64
+	VolumeBuyUSD            float64
+	VolumeBuyHistory        float64
+	VolumeBuyHistoryUSD     float64
+	VolumeBuyChangePercent  float64
+	VolumeSell              float64
+	VolumeSellUSD           float64
+	VolumeSellHistory       float64
+	VolumeSellHistoryUSD    float64
+	VolumeSellChangePercent float64
+}
+
+// TokenExtensions are the links and identifiers attached to a token.
+type TokenExtensions struct {
+	CoingeckoID string
+	Website     string
+	Twitter     string
+	Telegram    string
+	Discord     string
+	Medium      string
+	Description string
+}
+
+// UnmarshalJSON accepts both the camelCase keys of the v1 endpoints and
+// the snake_case keys of the v3 endpoints.
+func (e *TokenExtensions) UnmarshalJSON(data []byte) error {
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	fields := map[string]*string{
+		"coingeckoid": &e.CoingeckoID,
+		"website":     &e.Website,
+		"twitter":     &e.Twitter,
+		"telegram":    &e.Telegram,
+		"discord":     &e.Discord,
+		"medium":      &e.Medium,
+		"description": &e.Description,
+	}
+	for key, value := range raw {
+		field, ok := fields[strings.ToLower(strings.ReplaceAll(key, "_", ""))]
+		if s, isString := value.(string); ok && isString {
+			*field = s
+		}
+	}
+	return nil
+}
+
+// https://docs.birdeye.so/reference/get_defi-token-security
+// TokenSecurity holds the report matching the chain of the request: Solana
+// on Solana, EVM on the EVM chains.
+type TokenSecurity struct {
+	Solana *SolanaTokenSecurity
+	EVM    *EVMTokenSecurity
+}
+
+type SolanaTokenSecurity struct {
+	CreatorAddress      *string `json:"creatorAddress"`
+	CreatorOwnerAddress *string `json:"creatorOwnerAddress"`
+	CreatorBalance      Float   `json:"creatorBalance"`
+	CreatorPercentage   Float   `json:"creatorPercentage"`
+	// OwnerAddress is the mint authority, nil once it has been revoked.
+	OwnerAddress                   *string         `json:"ownerAddress"`
+	OwnerOfOwnerAddress            *string         `json:"ownerOfOwnerAddress"`
+	OwnerBalance                   Float           `json:"ownerBalance"`
+	OwnerPercentage                Float           `json:"ownerPercentage"`
+	CreationTx                     *string         `json:"creationTx"`
+	CreationTime                   UnixTime        `json:"creationTime"`
+	CreationSlot                   int64           `json:"creationSlot"`
+	MintTx                         *string         `json:"mintTx"`
+	MintTime                       UnixTime        `json:"mintTime"`
+	MintSlot                       int64           `json:"mintSlot"`
+	MetaplexUpdateAuthority        string          `json:"metaplexUpdateAuthority"`
+	MetaplexOwnerUpdateAuthority   *string         `json:"metaplexOwnerUpdateAuthority"`
+	MetaplexUpdateAuthorityBalance Float           `json:"metaplexUpdateAuthorityBalance"`
+	MetaplexUpdateAuthorityPercent Float           `json:"metaplexUpdateAuthorityPercent"`
+	MutableMetadata                Bool            `json:"mutableMetadata"`
+	Top10HolderBalance             Float           `json:"top10HolderBalance"`
+	Top10HolderPercent             Float           `json:"top10HolderPercent"`
+	Top10UserBalance               Float           `json:"top10UserBalance"`
+	Top10UserPercent               Float           `json:"top10UserPercent"`
+	IsTrueToken                    *Bool           `json:"isTrueToken"`
+	FakeToken                      *Bool           `json:"fakeToken"`
+	TotalSupply                    Float           `json:"totalSupply"`
+	PreMarketHolder                []string        `json:"preMarketHolder"`
+	LockInfo                       json.RawMessage `json:"lockInfo"`
+	Freezeable                     *Bool           `json:"freezeable"`
+	FreezeAuthority                *string         `json:"freezeAuthority"`
+	TransferFeeEnable              *Bool           `json:"transferFeeEnable"`
+	TransferFeeData                json.RawMessage `json:"transferFeeData"`
+	IsToken2022                    Bool            `json:"isToken2022"`
+	NonTransferable                *Bool           `json:"nonTransferable"`
+	JupStrictList                  *Bool           `json:"jupStrictList"`
+}
+
+type EVMTokenSecurity struct {
+	TokenName                  string `json:"tokenName"`
+	TokenSymbol                string `json:"tokenSymbol"`
+	TotalSupply                Float  `json:"totalSupply"`
+	HolderCount                Float  `json:"holderCount"`
+	CreatorAddress             string `json:"creatorAddress"`
+	CreatorBalance             Float  `json:"creatorBalance"`
+	CreatorPercentage          Float  `json:"creatorPercentage"`
+	OwnerAddress               string `json:"ownerAddress"`
+	OwnerBalance               Float  `json:"ownerBalance"`
+	OwnerPercentage            Float  `json:"ownerPercentage"`
+	LPHolderCount              Float  `json:"lpHolderCount"`
+	LPTotalSupply              Float  `json:"lpTotalSupply"`
+	Top10HolderPercent         Float  `json:"top10HolderPercent"`
+	BuyTax                     Float  `json:"buyTax"`
+	SellTax                    Float  `json:"sellTax"`
+	IsHoneypot                 *Bool  `json:"isHoneypot"`
+	HoneypotWithSameCreator    *Bool  `json:"honeypotWithSameCreator"`
+	IsMintable                 *Bool  `json:"isMintable"`
+	IsOpenSource               *Bool  `json:"isOpenSource"`
+	IsProxy                    *Bool  `json:"isProxy"`
+	IsInDex                    *Bool  `json:"isInDex"`
+	IsBlacklisted              *Bool  `json:"isBlacklisted"`
+	IsWhitelisted              *Bool  `json:"isWhitelisted"`
+	IsAntiWhale                *Bool  `json:"isAntiWhale"`
+	AntiWhaleModifiable        *Bool  `json:"antiWhaleModifiable"`
+	CannotBuy                  *Bool  `json:"cannotBuy"`
+	CannotSellAll              *Bool  `json:"cannotSellAll"`
+	CanTakeBackOwnership       *Bool  `json:"canTakeBackOwnership"`
+	OwnerChangeBalance         *Bool  `json:"ownerChangeBalance"`
+	HiddenOwner                *Bool  `json:"hiddenOwner"`
+	ExternalCall               *Bool  `json:"externalCall"`
+	Selfdestruct               *Bool  `json:"selfdestruct"`
+	SlippageModifiable         *Bool  `json:"slippageModifiable"`
+	PersonalSlippageModifiable *Bool  `json:"personalSlippageModifiable"`
+	TradingCooldown            *Bool  `json:"tradingCooldown"`
+	TransferPausable           *Bool  `json:"transferPausable"`
+}
+
+// Risks reported by TokenSecurity.RiskFlags.
+var (
+	RiskMintable               riskFlag = "mintable"
+	RiskFreezable              riskFlag = "freezable"
+	RiskMutableMetadata        riskFlag = "mutable_metadata"
+	RiskTopHolderConcentration riskFlag = "top_holder_concentration"
+	RiskTransferFee            riskFlag = "transfer_fee"
+	RiskNonTransferable        riskFlag = "non_transferable"
+	RiskFakeToken              riskFlag = "fake_token"
+	RiskHoneypot               riskFlag = "honeypot"
+	RiskHighTax                riskFlag = "high_tax"
+	RiskCannotBuy              riskFlag = "cannot_buy"
+	RiskCannotSell             riskFlag = "cannot_sell"
+	RiskBlacklist              riskFlag = "blacklist"
+	RiskProxy                  riskFlag = "proxy"
+	RiskNotOpenSource          riskFlag = "not_open_source"
+	RiskHiddenOwner            riskFlag = "hidden_owner"
+	RiskOwnerChangeBalance     riskFlag = "owner_change_balance"
+	RiskTakeBackOwnership      riskFlag = "take_back_ownership"
+	RiskSelfdestruct           riskFlag = "selfdestruct"
+	RiskTransferPausable       riskFlag = "transfer_pausable"
+)
+
+const (
+	// riskTopHolderPercent is the share of the supply held by the top 10
+	// holders above which RiskTopHolderConcentration is reported.
+	riskTopHolderPercent = 0.5
+	// riskTax is the buy or sell tax above which RiskHighTax is reported.
+	riskTax = 0.1
+)
+
+// RiskFlags turns the report into the list of risks it exhibits.
+func (s TokenSecurity) RiskFlags() (flags []riskFlag) {
+	add := func(cond bool, flag riskFlag) {
+		if cond {
+			flags = append(flags, flag)
+		}
+	}
+	// Flags the API did not report are nil and never raise a risk.
+	isTrue := func(b *Bool) bool {
+		return b != nil && bool(*b)
+	}
+	isFalse := func(b *Bool) bool {
+		return b != nil && !bool(*b)
+	}
+
+	if sol := s.Solana; sol != nil {
+		add(sol.OwnerAddress != nil && *sol.OwnerAddress != "", RiskMintable)
+		add(isTrue(sol.Freezeable) || (sol.FreezeAuthority != nil && *sol.FreezeAuthority != ""), RiskFreezable)
+		add(bool(sol.MutableMetadata), RiskMutableMetadata)
+		add(float64(sol.Top10HolderPercent) > riskTopHolderPercent, RiskTopHolderConcentration)
+		add(isTrue(sol.TransferFeeEnable), RiskTransferFee)
+		add(isTrue(sol.NonTransferable), RiskNonTransferable)
+		add(isTrue(sol.FakeToken), RiskFakeToken)
+	}
+
+	if evm := s.EVM; evm != nil {
+		add(isTrue(evm.IsMintable), RiskMintable)
+		add(float64(evm.Top10HolderPercent) > riskTopHolderPercent, RiskTopHolderConcentration)
+		add(isTrue(evm.IsHoneypot), RiskHoneypot)
+		add(float64(evm.BuyTax) > riskTax || float64(evm.SellTax) > riskTax, RiskHighTax)
+		add(isTrue(evm.CannotBuy), RiskCannotBuy)
+		add(isTrue(evm.CannotSellAll), RiskCannotSell)
+		add(isTrue(evm.IsBlacklisted), RiskBlacklist)
+		add(isTrue(evm.IsProxy), RiskProxy)
+		add(isFalse(evm.IsOpenSource), RiskNotOpenSource)
+		add(isTrue(evm.HiddenOwner), RiskHiddenOwner)
+		add(isTrue(evm.OwnerChangeBalance), RiskOwnerChangeBalance)
+		add(isTrue(evm.CanTakeBackOwnership), RiskTakeBackOwnership)
+		add(isTrue(evm.Selfdestruct), RiskSelfdestruct)
+		add(isTrue(evm.TransferPausable), RiskTransferPausable)
+	}
+
+	return flags
+}
+
+// https://docs.birdeye.so/reference/get_defi-token-creation-info
+type TokenCreationInfo struct {
+	TxHash         string   `json:"txHash"`
+	Slot           int64    `json:"slot"`
+	TokenAddress   string   `json:"tokenAddress"`
+	Decimals       int      `json:"decimals"`
+	Owner          string   `json:"owner"`
+	BlockUnixTime  UnixTime `json:"blockUnixTime"`
+	BlockHumanTime DateTime `json:"blockHumanTime"`
+}
+
+// https://docs.birdeye.so/reference/get_defi-v3-token-meta-data-single
+type TokenMetadata struct {
+	Address    string          `json:"address"`
+	Name       string          `json:"name"`
+	Symbol     string          `json:"symbol"`
+	Decimals   int             `json:"decimals"`
+	Extensions TokenExtensions `json:"extensions"`
+	LogoURI    string          `json:"logo_uri"`
+}
+
+// https://docs.birdeye.so/reference/get_defi-v3-token-meta-data-multiple
+type TokenMetadataMultiple map[string]TokenMetadata
+
+// https://docs.birdeye.so/reference/get_defi-v3-token-market-data
+type TokenMarketData struct {
+	Address              string  `json:"address"`
+	Price                float64 `json:"price"`
+	Liquidity            float64 `json:"liquidity"`
+	Supply               float64 `json:"supply"`
+	TotalSupply          float64 `json:"total_supply"`
+	CirculatingSupply    float64 `json:"circulating_supply"`
+	MarketCap            float64 `json:"marketcap"`
+	CirculatingMarketCap float64 `json:"circulating_marketcap"`
+	FDV                  float64 `json:"fdv"`
+}
+
+// https://docs.birdeye.so/reference/get_defi-v3-token-market-data-multiple
+type TokenMarketDataMultiple map[string]TokenMarketData
+
+// https://docs.birdeye.so/reference/get_defi-v3-token-trade-data-single
+type TokenTradeData struct {
+	Address            string   `json:"address"`
+	Holder             int      `json:"holder"`
+	Market             int      `json:"market"`
+	LastTradeUnixTime  UnixTime `json:"last_trade_unix_time"`
+	LastTradeHumanTime DateTime `json:"last_trade_human_time"`
+	Price              float64  `json:"price"`
+	windowed
+}
+
+func (t *TokenTradeData) UnmarshalJSON(data []byte) error {
+	type plain TokenTradeData
+	if err := json.Unmarshal(data, (*plain)(t)); err != nil {
+		return err
+	}
+	return t.windowed.decode(data, true)
+}
+
+// https://docs.birdeye.so/reference/get_defi-v3-token-trade-data-multiple
+type TokenTradeDataMultiple map[string]TokenTradeData
+
+// https://docs.birdeye.so/reference/get_defi-v3-token-list
+type TokenList struct {
+	Items   []TokenListItem `json:"items"`
+	HasNext bool            `json:"has_next"`
+}
+
+// https://docs.birdeye.so/reference/get_defi-v3-token-list-scroll
+type TokenListScrollPage struct {
+	Items        []TokenListItem `json:"items"`
+	NextScrollID string          `json:"next_scroll_id"`
+}
+
+type TokenListItem struct {
+	Address                string          `json:"address"`
+	Name                   string          `json:"name"`
+	Symbol                 string          `json:"symbol"`
+	Decimals               int             `json:"decimals"`
+	LogoURI                string          `json:"logo_uri"`
+	Extensions             TokenExtensions `json:"extensions"`
+	Price                  float64         `json:"price"`
+	Liquidity              float64         `json:"liquidity"`
+	MarketCap              float64         `json:"market_cap"`
+	FDV                    float64         `json:"fdv"`
+	Holder                 int             `json:"holder"`
+	RecentListingTime      UnixTime        `json:"recent_listing_time"`
+	LastTradeUnixTime      UnixTime        `json:"last_trade_unix_time"`
+	Volume1hUSD            float64         `json:"volume_1h_usd"`
+	Volume1hChangePercent  float64         `json:"volume_1h_change_percent"`
+	PriceChange1hPercent   float64         `json:"price_change_1h_percent"`
+	Trade1hCount           int             `json:"trade_1h_count"`
+	Volume2hUSD            float64         `json:"volume_2h_usd"`
+	Volume2hChangePercent  float64         `json:"volume_2h_change_percent"`
+	PriceChange2hPercent   float64         `json:"price_change_2h_percent"`
+	Trade2hCount           int             `json:"trade_2h_count"`
+	Volume4hUSD            float64         `json:"volume_4h_usd"`
+	Volume4hChangePercent  float64         `json:"volume_4h_change_percent"`
+	PriceChange4hPercent   float64         `json:"price_change_4h_percent"`
+	Trade4hCount           int             `json:"trade_4h_count"`
+	Volume8hUSD            float64         `json:"volume_8h_usd"`
+	Volume8hChangePercent  float64         `json:"volume_8h_change_percent"`
+	PriceChange8hPercent   float64         `json:"price_change_8h_percent"`
+	Trade8hCount           int             `json:"trade_8h_count"`
+	Volume24hUSD           float64         `json:"volume_24h_usd"`
+	Volume24hChangePercent float64         `json:"volume_24h_change_percent"`
+	PriceChange24hPercent  float64         `json:"price_change_24h_percent"`
+	Trade24hCount          int             `json:"trade_24h_count"`
+}
+
+// https://docs.birdeye.so/reference/get_defi-v3-token-holder
+type TokenHolderList struct {
+	Items []TokenHolder `json:"items"`
+}
+
+type TokenHolder struct {
+	Owner        string  `json:"owner"`
+	TokenAccount string  `json:"token_account"`
+	Mint         string  `json:"mint"`
+	Amount       Amount  `json:"amount"`
+	UIAmount     float64 `json:"ui_amount"`
+	Decimals     int     `json:"decimals"`
+}
+
+// AmountDecimal returns Amount scaled by Decimals without float rounding.
+func (h TokenHolder) AmountDecimal() string {
+	return h.Amount.Decimal(h.Decimals)
+}
+
+// HolderConcentration returns the percentage (0 to 100) of supply held by
+// the n largest holders. supply is the UI supply of the token, as reported
+// by TokenOverview or TokenMarketData.
+func HolderConcentration(holders []TokenHolder, supply float64, n int) float64 {
+	if supply <= 0 || n <= 0 {
+		return 0
+	}
+
+	amounts := make([]float64, len(holders))
+	for i, h := range holders {
+		amounts[i] = h.UIAmount
+	}
+	slices.SortFunc(amounts, func(a, b float64) int {
+		return cmp.Compare(b, a)
+	})
+
+	var held float64
+	for _, amount := range amounts[:min(n, len(amounts))] {
+		held += amount
+	}
+	return held / supply * 100
+}
+
+// https://docs.birdeye.so/reference/get_defi-v2-tokens-top-traders
+type TopTraderList struct {
+	Items []TopTrader `json:"items"`
+}
+
+type TopTrader struct {
+	TokenAddress string   `json:"tokenAddress"`
+	Owner        string   `json:"owner"`
+	Tags         []string `json:"tags"`
+	Type         string   `json:"type"`
+	Volume       float64  `json:"volume"`
+	VolumeBuy    float64  `json:"volumeBuy"`
+	VolumeSell   float64  `json:"volumeSell"`
+	Trade        int      `json:"trade"`
+	TradeBuy     int      `json:"tradeBuy"`
+	TradeSell    int      `json:"tradeSell"`
+}
+
+// TokenRef identifies a token inside another response, such as the base
+// and quote tokens of a market.
+type TokenRef struct {
+	Address  string `json:"address"`
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Decimals int    `json:"decimals"`
+	LogoURI  string `json:"logoURI"`
+}
+
+// UnmarshalJSON accepts the logo under any of the keys used across
+// endpoints: icon, logoURI or logo_uri.
+func (t *TokenRef) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Address   string `json:"address"`
+		Symbol    string `json:"symbol"`
+		Name      string `json:"name"`
+		Decimals  int    `json:"decimals"`
+		Icon      string `json:"icon"`
+		LogoURI   string `json:"logoURI"`
+		LogoURIV3 string `json:"logo_uri"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*t = TokenRef{
+		Address:  raw.Address,
+		Symbol:   raw.Symbol,
+		Name:     raw.Name,
+		Decimals: raw.Decimals,
+		LogoURI:  cmp.Or(raw.Icon, raw.LogoURI, raw.LogoURIV3),
+	}
+	return nil
+}
+
+// https://docs.birdeye.so/reference/get_defi-v2-markets
+type TokenMarketList struct {
+	Items []TokenMarket `json:"items"`
+	Total int           `json:"total"`
+}
+
+type TokenMarket struct {
+	Address                      string   `json:"address"`
+	Name                         string   `json:"name"`
+	Source                       string   `json:"source"`
+	Base                         TokenRef `json:"base"`
+	Quote                        TokenRef `json:"quote"`
+	CreatedAt                    DateTime `json:"createdAt"`
+	Price                        *Float   `json:"price"`
+	Liquidity                    float64  `json:"liquidity"`
+	Volume24h                    float64  `json:"volume24h"`
+	Trade24h                     int      `json:"trade24h"`
+	Trade24hChangePercent        float64  `json:"trade24hChangePercent"`
+	UniqueWallet24h              int      `json:"uniqueWallet24h"`
+	UniqueWallet24hChangePercent float64  `json:"uniqueWallet24hChangePercent"`
+}
+
+// https://docs.birdeye.so/reference/get_defi-v3-pair-overview-single
+type PairOverview struct {
+	Address                      string   `json:"address"`
+	Name                         string   `json:"name"`
+	Source                       string   `json:"source"`
+	Base                         TokenRef `json:"base"`
+	Quote                        TokenRef `json:"quote"`
+	CreatedAt                    DateTime `json:"created_at"`
+	Price                        float64  `json:"price"`
+	Liquidity                    float64  `json:"liquidity"`
+	LiquidityChangePercentage24h *Float   `json:"liquidity_change_percentage_24h"`
+	Volume24hBase                float64  `json:"volume_24h_base"`
+	Volume24hQuote               float64  `json:"volume_24h_quote"`
+	windowed
+}
+
+func (p *PairOverview) UnmarshalJSON(data []byte) error {
+	type plain PairOverview
+	if err := json.Unmarshal(data, (*plain)(p)); err != nil {
+		return err
+	}
+	return p.windowed.decode(data, true)
+}
+
+// https://docs.birdeye.so/reference/get_defi-v3-pair-overview-multiple
+type PairOverviewMultiple map[string]PairOverview
@@ -0,0 +1,62 @@
+package birdeye_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	birdeye "github.com/Dzirael/birdeye-go"
+)
+
+// The inputs below are synthetic: they exercise the tolerant decoders with
+// the null and numeric string variants the API is known to send.
+
+func TestTradeNullableFields(t *testing.T) {
+	var trade birdeye.Trade
+	err := json.Unmarshal([]byte(`{
+		"side": "buy",
+		"basePrice": null,
+		"quotePrice": "0.9999",
+		"alias": null,
+		"tokenPrice": 140.4,
+		"from": {"uiAmount": 25, "price": null, "nearestPrice": 0.9999},
+		"to": {"uiAmount": 0.178, "price": null, "nearestPrice": 140.35, "feeInfo": null}
+	}`), &trade)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if trade.BasePrice != nil || trade.Alias != nil || trade.From.Price != nil || trade.To.FeeInfo != nil {
+		t.Errorf("null fields decoded as %v %v %v %v", trade.BasePrice, trade.Alias, trade.From.Price, trade.To.FeeInfo)
+	}
+	if trade.QuotePrice.Float64() != 0.9999 || trade.TokenPrice.Float64() != 140.4 {
+		t.Errorf("prices %v %v, want 0.9999 140.4", trade.QuotePrice.Float64(), trade.TokenPrice.Float64())
+	}
+	if !trade.IsBuy() {
+		t.Error("IsBuy() = false for a buy")
+	}
+	if v, ok := trade.USDValue(); !ok || v < 24.99 || v > 25 {
+		t.Errorf("USDValue() = %v, %v, want about 25 from the nearest price", v, ok)
+	}
+
+	var unpriced birdeye.Trade
+	if err := json.Unmarshal([]byte(`{"side": "sell", "from": {"uiAmount": 1, "price": null}}`), &unpriced); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := unpriced.USDValue(); ok || unpriced.IsBuy() {
+		t.Errorf("unpriced sell reported as priced or buy")
+	}
+}
+
+func TestBoolVariants(t *testing.T) {
+	for in, want := range map[string]bool{`true`: true, `"1"`: true, `1`: true, `"false"`: false, `0`: false, `null`: false} {
+		var b birdeye.Bool
+		if err := json.Unmarshal([]byte(in), &b); err != nil || bool(b) != want {
+			t.Errorf("%s decoded as %v, %v, want %v", in, b, err, want)
+		}
+	}
+
+	var b birdeye.Bool
+	if err := json.Unmarshal([]byte(`"maybe"`), &b); err == nil {
+		t.Error("invalid flag accepted")
+	}
+}
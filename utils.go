@@ -1,8 +1,86 @@
 package birdeye
 
-func toString(arr []string) (str string) {
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// defaultBatchConcurrency is the number of batches sent in parallel by the
+// batching helpers unless configured otherwise.
+const defaultBatchConcurrency = 4
+
+func toString(arr []string) string {
+	return strings.Join(arr, ",")
+}
+
+// dedupe returns arr without empty and repeated entries, keeping the first occurrence.
+func dedupe(arr []string) []string {
+	seen := make(map[string]struct{}, len(arr))
+	out := make([]string, 0, len(arr))
 	for _, v := range arr {
-		str += v + ","
+		if _, ok := seen[v]; ok || v == "" {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
+
+// batch calls fn for every chunk of at most size items with at most
+// concurrency calls in flight. Failed chunks are reported in a *PartialError.
+func batch(ctx context.Context, items []string, size, concurrency int, fn func(ctx context.Context, chunk []string) error) error {
+	chunks := slices.Collect(slices.Chunk(items, size))
+	errs := runConcurrent(ctx, len(chunks), concurrency, func(ctx context.Context, i int) error {
+		return fn(ctx, chunks[i])
+	})
+
+	var partial PartialError
+	for i, err := range errs {
+		if err != nil {
+			partial.Failed = append(partial.Failed, BatchError{Items: chunks[i], Err: err})
+		}
+	}
+
+	if len(partial.Failed) > 0 {
+		partial.Batches = len(chunks)
+		return &partial
 	}
-	return str[:len(str)-1]
+	return nil
+}
+
+// runConcurrent calls fn for every index in [0, n) with at most limit calls
+// in flight and returns the error of each call. Indexes not started because
+// ctx was done report ctx.Err().
+func runConcurrent(ctx context.Context, n, limit int, fn func(ctx context.Context, i int) error) []error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	errs := make([]error, n)
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = fn(ctx, i)
+		}()
+	}
+
+	wg.Wait()
+	return errs
 }
@@ -0,0 +1,304 @@
+package birdeye
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Float is a number the API may send as a JSON number, a numeric string or
+// null. Nullable fields are declared as *Float and stay nil for null.
+type Float float64
+
+func (f *Float) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*f = 0
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return errors.Wrapf(err, "Birdeye: invalid number %s", data)
+	}
+
+	*f = Float(v)
+	return nil
+}
+
+// Float64 returns the value of f, or 0 when f is nil.
+func (f *Float) Float64() float64 {
+	if f == nil {
+		return 0
+	}
+	return float64(*f)
+}
+
+// Bool is a flag the API may send as a JSON boolean, as 0/1 (number or
+// string) or as "true"/"false". Null decodes to false.
+type Bool bool
+
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	switch strings.ToLower(strings.Trim(string(data), `"`)) {
+	case "", "null", "0", "false":
+		*b = false
+	case "1", "true":
+		*b = true
+	default:
+		return errors.Errorf("Birdeye: invalid flag %s", data)
+	}
+	return nil
+}
+
+// Amount is a raw token amount in base units. It decodes from JSON numbers
+// and numeric strings without losing precision, which matters for 18
+// decimal EVM tokens and large SPL supplies.
+type Amount struct {
+	i *big.Int
+}
+
+// NewAmount returns an Amount holding a copy of i.
+func NewAmount(i *big.Int) Amount {
+	if i == nil {
+		return Amount{}
+	}
+	return Amount{i: new(big.Int).Set(i)}
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		a.i = nil
+		return nil
+	}
+
+	if i, ok := new(big.Int).SetString(s, 10); ok {
+		a.i = i
+		return nil
+	}
+
+	// Large amounts are sometimes serialized in exponent notation.
+	f, ok := new(big.Float).SetPrec(256).SetString(s)
+	if !ok || !f.IsInt() {
+		return errors.Errorf("Birdeye: invalid amount %s", data)
+	}
+	a.i, _ = f.Int(nil)
+	return nil
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// Int returns a copy of the raw amount, 0 when unset.
+func (a Amount) Int() *big.Int {
+	if a.i == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(a.i)
+}
+
+// IsZero reports whether the amount is unset or 0.
+func (a Amount) IsZero() bool {
+	return a.i == nil || a.i.Sign() == 0
+}
+
+func (a Amount) String() string {
+	if a.i == nil {
+		return "0"
+	}
+	return a.i.String()
+}
+
+// Decimal formats the amount scaled down by decimals, without rounding,
+// e.g. Amount 1500000 with 6 decimals is "1.5".
+func (a Amount) Decimal(decimals int) string {
+	digits := a.String()
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+
+	if decimals <= 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	whole, frac := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if frac == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + frac
+}
+
+// Rat returns the amount scaled down by decimals as an exact rational.
+func (a Amount) Rat(decimals int) *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(decimals, 0))), nil)
+	return new(big.Rat).SetFrac(a.Int(), scale)
+}
+
+// UnixTime is a time the API sends as unix seconds, either as a JSON
+// number or a numeric string. Null and 0 decode to the zero time.
+type UnixTime struct {
+	time.Time
+}
+
+func (t *UnixTime) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		t.Time = time.Time{}
+		if sec != 0 {
+			t.Time = time.Unix(sec, 0)
+		}
+		return nil
+	}
+
+	// Fractional seconds, e.g. "1726676154.5".
+	sec, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return errors.Wrapf(err, "Birdeye: invalid unix time %s", data)
+	}
+
+	whole, frac := math.Modf(sec)
+	if math.IsNaN(sec) || math.Abs(whole) >= math.MaxInt64 {
+		return errors.Errorf("Birdeye: unix time %s out of range", data)
+	}
+
+	t.Time = time.Time{}
+	if sec != 0 {
+		t.Time = time.Unix(int64(whole), int64(frac*float64(time.Second)))
+	}
+	return nil
+}
+
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+// dateTimeLayouts are the formats in which the API sends human readable
+// times. Times without a zone are UTC.
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05",
+}
+
+// DateTime is a time the API sends as an ISO 8601 string.
+// Null and empty strings decode to the zero time.
+type DateTime struct {
+	time.Time
+}
+
+func (t *DateTime) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	for _, layout := range dateTimeLayouts {
+		if parsed, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	return errors.Errorf("Birdeye: invalid date time %s", data)
+}
+
+func (t DateTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
+
+// windowFields lists the WindowStats fields with the key patterns used by
+// the camelCase (v1) and snake_case (v3) endpoints, %s being the window.
+var windowFields = []struct {
+	camel, snake string
+	field        func(*WindowStats) any
+}{
+	{"history%sPrice", "history_%s_price", func(s *WindowStats) any { return &s.HistoryPrice }},
+	{"priceChange%sPercent", "price_change_%s_percent", func(s *WindowStats) any { return &s.PriceChangePercent }},
+	{"uniqueWallet%s", "unique_wallet_%s", func(s *WindowStats) any { return &s.UniqueWallet }},
+	{"uniqueWalletHistory%s", "unique_wallet_history_%s", func(s *WindowStats) any { return &s.UniqueWalletHistory }},
+	{"uniqueWallet%sChangePercent", "unique_wallet_%s_change_percent", func(s *WindowStats) any { return &s.UniqueWalletChangePercent }},
+	{"trade%s", "trade_%s", func(s *WindowStats) any { return &s.Trade }},
+	{"tradeHistory%s", "trade_history_%s", func(s *WindowStats) any { return &s.TradeHistory }},
+	{"trade%sChangePercent", "trade_%s_change_percent", func(s *WindowStats) any { return &s.TradeChangePercent }},
+	{"buy%s", "buy_%s", func(s *WindowStats) any { return &s.Buy }},
+	{"buyHistory%s", "buy_history_%s", func(s *WindowStats) any { return &s.BuyHistory }},
+	{"buy%sChangePercent", "buy_%s_change_percent", func(s *WindowStats) any { return &s.BuyChangePercent }},
+	{"sell%s", "sell_%s", func(s *WindowStats) any { return &s.Sell }},
+	{"sellHistory%s", "sell_history_%s", func(s *WindowStats) any { return &s.SellHistory }},
+	{"sell%sChangePercent", "sell_%s_change_percent", func(s *WindowStats) any { return &s.SellChangePercent }},
+	{"v%s", "volume_%s", func(s *WindowStats) any { return &s.Volume }},
+	{"v%sUSD", "volume_%s_usd", func(s *WindowStats) any { return &s.VolumeUSD }},
+	{"vHistory%s", "volume_history_%s", func(s *WindowStats) any { return &s.VolumeHistory }},
+	{"vHistory%sUSD", "volume_history_%s_usd", func(s *WindowStats) any { return &s.VolumeHistoryUSD }},
+	{"v%sChangePercent", "volume_%s_change_percent", func(s *WindowStats) any { return &s.VolumeChangePercent }},
+	{"vBuy%s", "volume_buy_%s", func(s *WindowStats) any { return &s.VolumeBuy }},
+	{"vBuy%sUSD", "volume_buy_%s_usd", func(s *WindowStats) any { return &s.VolumeBuyUSD }},
+	{"vBuyHistory%s", "volume_buy_history_%s", func(s *WindowStats) any { return &s.VolumeBuyHistory }},
+	{"vBuyHistory%sUSD", "volume_buy_history_%s_usd", func(s *WindowStats) any { return &s.VolumeBuyHistoryUSD }},
+	{"vBuy%sChangePercent", "volume_buy_%s_change_percent", func(s *WindowStats) any { return &s.VolumeBuyChangePercent }},
+	{"vSell%s", "volume_sell_%s", func(s *WindowStats) any { return &s.VolumeSell }},
+	{"vSell%sUSD", "volume_sell_%s_usd", func(s *WindowStats) any { return &s.VolumeSellUSD }},
+	{"vSellHistory%s", "volume_sell_history_%s", func(s *WindowStats) any { return &s.VolumeSellHistory }},
+	{"vSellHistory%sUSD", "volume_sell_history_%s_usd", func(s *WindowStats) any { return &s.VolumeSellHistoryUSD }},
+	{"vSell%sChangePercent", "volume_sell_%s_change_percent", func(s *WindowStats) any { return &s.VolumeSellChangePercent }},
+}
+
+// decodeWindows collects the flat per-window keys of data into one
+// WindowStats per window. Windows without any key are left out.
+func decodeWindows(data []byte, snake bool) (map[window]WindowStats, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	stats := make(map[window]WindowStats)
+	for _, w := range allWindows {
+		var s WindowStats
+		found := false
+		for _, f := range windowFields {
+			pattern := f.camel
+			if snake {
+				pattern = f.snake
+			}
+
+			value, ok := raw[fmt.Sprintf(pattern, w)]
+			if !ok {
+				continue
+			}
+
+			if err := json.Unmarshal(value, f.field(&s)); err != nil {
+				return nil, errors.Wrapf(err, "Birdeye: decode %s", fmt.Sprintf(pattern, w))
+			}
+			found = true
+		}
+
+		if found {
+			stats[w] = s
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,67 @@
+package birdeye_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	birdeye "github.com/Dzirael/birdeye-go"
+)
+
+// The inputs below are synthetic edge cases of the raw amount encodings.
+
+func TestAmountUnmarshal(t *testing.T) {
+	for _, c := range []struct {
+		in, want, decimal string
+		decimals          int
+	}{
+		{`1500000`, "1500000", "1.5", 6},
+		{`"125000000000000000000000"`, "125000000000000000000000", "125000", 18},
+		{`-1093000`, "-1093000", "-0.001093", 9},
+		{`1.25e+21`, "1250000000000000000000", "1250", 18},
+		{`null`, "0", "0", 9},
+	} {
+		var a birdeye.Amount
+		if err := json.Unmarshal([]byte(c.in), &a); err != nil {
+			t.Errorf("%s: %v", c.in, err)
+			continue
+		}
+		if a.String() != c.want || a.Decimal(c.decimals) != c.decimal {
+			t.Errorf("%s decoded as %s (%s), want %s (%s)", c.in, a, a.Decimal(c.decimals), c.want, c.decimal)
+		}
+	}
+
+	var a birdeye.Amount
+	if err := json.Unmarshal([]byte(`"1.5"`), &a); err == nil {
+		t.Error("fractional amount accepted")
+	}
+}
+
+// The inputs below are synthetic edge cases of the unix time encodings.
+
+func TestUnixTimeUnmarshal(t *testing.T) {
+	for in, want := range map[string]time.Time{
+		`1726676154`:     time.Unix(1726676154, 0),
+		`"1726676154"`:   time.Unix(1726676154, 0),
+		`"1726676154.5"`: time.Unix(1726676154, int64(500*time.Millisecond)),
+		`1700000000000`:  time.Unix(1700000000000, 0),
+		`0`:              {},
+		`null`:           {},
+	} {
+		var u birdeye.UnixTime
+		if err := json.Unmarshal([]byte(in), &u); err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+		if !u.Equal(want) {
+			t.Errorf("%s decoded as %s, want %s", in, u.Time, want)
+		}
+	}
+
+	for _, in := range []string{`1e30`, `"soon"`} {
+		var u birdeye.UnixTime
+		if err := json.Unmarshal([]byte(in), &u); err == nil {
+			t.Errorf("%s accepted as %s", in, u.Time)
+		}
+	}
+}